@@ -0,0 +1,146 @@
+package dynamicarray
+
+import "testing"
+
+// testArrayConformance checks the behaviour shared by every Array implementation.
+// A new implementation only has to provide a constructor of an empty array.
+func testArrayConformance(t *testing.T, newArray func() Array[int]) {
+	t.Helper()
+
+	fill := func(t *testing.T, values ...int) Array[int] {
+		t.Helper()
+		array := newArray()
+		for _, value := range values {
+			if err := array.Add(value); err != nil {
+				t.Fatalf("unexpected error on add: %s", err)
+			}
+		}
+		return array
+	}
+
+	assertElements := func(t *testing.T, array Array[int], expected ...int) {
+		t.Helper()
+		if array.Size() != int32(len(expected)) {
+			t.Fatalf("expected length '%d', but got '%d'", len(expected), array.Size())
+		}
+		for index, value := range expected {
+			actual, err := array.Get(int32(index))
+			assertError(t, err, nil)
+			assertEqual(t, actual, value)
+		}
+	}
+
+	t.Run("a new array is empty", func(t *testing.T) {
+		array := newArray()
+
+		assertElements(t, array)
+		if array.Capacity() < array.Size() {
+			t.Errorf("capacity '%d' is less than length '%d'", array.Capacity(), array.Size())
+		}
+	})
+
+	t.Run("add keeps insertion order", func(t *testing.T) {
+		array := fill(t, 1, 2, 3, 4, 5)
+
+		assertElements(t, array, 1, 2, 3, 4, 5)
+	})
+
+	t.Run("get and set out of range", func(t *testing.T) {
+		array := fill(t, 1)
+
+		_, err := array.Get(1)
+		assertError(t, err, ErrorIndexOutOfRange)
+
+		_, err = array.Get(-1)
+		assertError(t, err, ErrorIndexOutOfRange)
+
+		err = array.Set(1, 10)
+		assertError(t, err, ErrorIndexOutOfRange)
+	})
+
+	t.Run("set replaces an element", func(t *testing.T) {
+		array := fill(t, 1, 2, 3)
+
+		err := array.Set(1, 20)
+
+		assertError(t, err, nil)
+		assertElements(t, array, 1, 20, 3)
+	})
+
+	t.Run("insert at the beginning, middle and end", func(t *testing.T) {
+		array := fill(t, 2, 4)
+
+		assertError(t, array.InsertAt(0, 1), nil)
+		assertError(t, array.InsertAt(2, 3), nil)
+		assertError(t, array.InsertAt(4, 5), nil)
+
+		assertElements(t, array, 1, 2, 3, 4, 5)
+	})
+
+	t.Run("insert out of range", func(t *testing.T) {
+		array := fill(t, 1)
+
+		assertError(t, array.InsertAt(2, 3), ErrorIndexOutOfRange)
+		assertError(t, array.InsertAt(-1, 3), ErrorIndexOutOfRange)
+		assertElements(t, array, 1)
+	})
+
+	t.Run("find and contains", func(t *testing.T) {
+		array := fill(t, 5, 6, 7, 6)
+
+		index, err := array.Find(6)
+
+		assertError(t, err, nil)
+		assertEqual(t, index, int32(1))
+		assertEqual(t, array.Contains(7), true)
+		assertEqual(t, array.Contains(8), false)
+
+		_, err = array.Find(8)
+		assertError(t, err, ErrorElementNotFound)
+	})
+
+	t.Run("remove deletes the first occurrence", func(t *testing.T) {
+		array := fill(t, 5, 6, 7, 6)
+
+		assertError(t, array.Remove(6), nil)
+		assertElements(t, array, 5, 7, 6)
+
+		assertError(t, array.Remove(8), ErrorElementNotFound)
+		assertElements(t, array, 5, 7, 6)
+	})
+
+	t.Run("remove at an index", func(t *testing.T) {
+		array := fill(t, 1, 2, 3)
+
+		assertError(t, array.RemoveAt(0), nil)
+		assertElements(t, array, 2, 3)
+
+		assertError(t, array.RemoveAt(2), ErrorIndexOutOfRange)
+		assertElements(t, array, 2, 3)
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		array := fill(t, 1, 2, 3, 4)
+
+		array.Reverse()
+
+		assertElements(t, array, 4, 3, 2, 1)
+	})
+
+	t.Run("clear removes every element", func(t *testing.T) {
+		array := fill(t, 1, 2, 3)
+
+		array.Clear()
+
+		assertElements(t, array)
+		assertEqual(t, array.Contains(1), false)
+		assertError(t, array.Add(4), nil)
+		assertElements(t, array, 4)
+	})
+}
+
+func TestDynamicArrayConformance(t *testing.T) {
+	testArrayConformance(t, func() Array[int] {
+		return NewDynamicArray[int]()
+	})
+}
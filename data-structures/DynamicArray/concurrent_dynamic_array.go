@@ -0,0 +1,150 @@
+package dynamicarray
+
+import "sync"
+
+var _ Array[int] = (*ConcurrentDynamicArray[int])(nil)
+
+// ConcurrentDynamicArray is a dynamic array safe for concurrent use. Reads run
+// in parallel, while writes are exclusive.
+type ConcurrentDynamicArray[T any] struct {
+	mutex sync.RWMutex
+	array *DynamicArray[T]
+}
+
+// NewConcurrentDynamicArray creates a new concurrent dynamic array configured
+// the same way NewDynamicArray does.
+func NewConcurrentDynamicArray[T any](options ...Option) *ConcurrentDynamicArray[T] {
+	return &ConcurrentDynamicArray[T]{array: NewDynamicArray[T](options...)}
+}
+
+// Add appends an element to the end of an array.
+func (c *ConcurrentDynamicArray[T]) Add(element T) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.array.Add(element)
+}
+
+// AddAll appends elements to the end of an array as a single operation.
+func (c *ConcurrentDynamicArray[T]) AddAll(elements ...T) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.array.AddAll(elements...)
+}
+
+// AppendIfAbsent appends an element unless it is already in an array. It
+// reports whether the element has been appended.
+func (c *ConcurrentDynamicArray[T]) AppendIfAbsent(element T) (bool, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.array.Contains(element) {
+		return false, nil
+	}
+	if err := c.array.Add(element); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Capacity returns a capacity of an array.
+func (c *ConcurrentDynamicArray[T]) Capacity() int32 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.array.Capacity()
+}
+
+// Clear removes all elements from an array keeping its capacity.
+func (c *ConcurrentDynamicArray[T]) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.array.Clear()
+}
+
+// Contains reports whether an element is present in an array.
+func (c *ConcurrentDynamicArray[T]) Contains(element T) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.array.Contains(element)
+}
+
+// Find performs linear search on an array.
+func (c *ConcurrentDynamicArray[T]) Find(element T) (int32, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.array.Find(element)
+}
+
+// Get returns an element by a given index.
+func (c *ConcurrentDynamicArray[T]) Get(index int32) (T, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.array.Get(index)
+}
+
+// InsertAt puts an element at a given index shifting the following elements.
+func (c *ConcurrentDynamicArray[T]) InsertAt(index int32, element T) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.array.InsertAt(index, element)
+}
+
+// Remove deletes the first occurrence of an element from an array.
+func (c *ConcurrentDynamicArray[T]) Remove(element T) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.array.Remove(element)
+}
+
+// RemoveAt deletes an element at a given index.
+func (c *ConcurrentDynamicArray[T]) RemoveAt(index int32) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.array.RemoveAt(index)
+}
+
+// Reverse an array.
+func (c *ConcurrentDynamicArray[T]) Reverse() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.array.Reverse()
+}
+
+// Set an element by a given index.
+func (c *ConcurrentDynamicArray[T]) Set(index int32, element T) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.array.Set(index, element)
+}
+
+// Size returns a number of elements in an array.
+func (c *ConcurrentDynamicArray[T]) Size() int32 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.array.Size()
+}
+
+// Update replaces an element at a given index with a result of a function
+// called with the current element. Nobody can change the array in between.
+func (c *ConcurrentDynamicArray[T]) Update(index int32, update func(old T) T) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	old, err := c.array.Get(index)
+	if err != nil {
+		return err
+	}
+	return c.array.Set(index, update(old))
+}
+
+// Snapshot returns a non-concurrent copy of an array that doesn't share
+// storage with it.
+func (c *ConcurrentDynamicArray[T]) Snapshot() *DynamicArray[T] {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.array.Clone()
+}
+
+// ToSlice returns a copy of elements of an array.
+func (c *ConcurrentDynamicArray[T]) ToSlice() []T {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.array.ToSlice()
+}
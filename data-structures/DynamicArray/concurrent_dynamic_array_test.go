@@ -0,0 +1,118 @@
+package dynamicarray
+
+import (
+	"sync"
+	"testing"
+)
+
+const (
+	workers          = 8
+	operationsPerRun = 1000
+)
+
+func runConcurrently(t *testing.T, work func(worker int)) {
+	t.Helper()
+	var group sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		group.Add(1)
+		go func(worker int) {
+			defer group.Done()
+			work(worker)
+		}(worker)
+	}
+	group.Wait()
+}
+
+func TestConcurrentDynamicArrayConformance(t *testing.T) {
+	testArrayConformance(t, func() Array[int] {
+		return NewConcurrentDynamicArray[int]()
+	})
+}
+
+func TestConcurrentDynamicArray_Add(t *testing.T) {
+	array := NewConcurrentDynamicArray[int]()
+
+	runConcurrently(t, func(worker int) {
+		for i := 0; i < operationsPerRun; i++ {
+			if err := array.Add(worker*operationsPerRun + i); err != nil {
+				t.Errorf("unexpected error on add: %s", err)
+			}
+			_, _ = array.Get(0)
+			_ = array.Size()
+		}
+	})
+
+	assertEqual(t, array.Size(), int32(workers*operationsPerRun))
+	seen := make(map[int]bool)
+	for _, element := range array.ToSlice() {
+		seen[element] = true
+	}
+	assertEqual(t, len(seen), workers*operationsPerRun)
+}
+
+func TestConcurrentDynamicArray_AppendIfAbsent(t *testing.T) {
+	array := NewConcurrentDynamicArray[int]()
+	appended := make([]int, workers)
+
+	runConcurrently(t, func(worker int) {
+		for i := 0; i < operationsPerRun/10; i++ {
+			ok, err := array.AppendIfAbsent(i)
+			assertError(t, err, nil)
+			if ok {
+				appended[worker]++
+			}
+		}
+	})
+
+	total := 0
+	for _, count := range appended {
+		total += count
+	}
+	assertEqual(t, total, operationsPerRun/10)
+	assertEqual(t, array.Size(), int32(operationsPerRun/10))
+
+	ok, err := array.AppendIfAbsent(0)
+	assertEqual(t, ok, false)
+	assertError(t, err, nil)
+}
+
+func TestConcurrentDynamicArray_Update(t *testing.T) {
+	array := NewConcurrentDynamicArray[int]()
+	_ = array.AddAll(0, 0)
+
+	runConcurrently(t, func(worker int) {
+		for i := 0; i < operationsPerRun; i++ {
+			_ = array.Update(int32(worker%2), func(old int) int { return old + 1 })
+		}
+	})
+
+	assertSlice(t, array.ToSlice(), []int{workers * operationsPerRun / 2, workers * operationsPerRun / 2})
+	assertError(t, array.Update(2, func(old int) int { return old }), ErrorIndexOutOfRange)
+}
+
+func TestConcurrentDynamicArray_MixedOperations(t *testing.T) {
+	array := NewConcurrentDynamicArray[int]()
+
+	runConcurrently(t, func(worker int) {
+		for i := 0; i < operationsPerRun; i++ {
+			switch i % 5 {
+			case 0:
+				_ = array.Add(i)
+			case 1:
+				_ = array.InsertAt(0, i)
+			case 2:
+				_ = array.Contains(i)
+			case 3:
+				_ = array.Snapshot()
+			case 4:
+				_ = array.RemoveAt(0)
+			}
+		}
+	})
+
+	snapshot := array.Snapshot()
+	assertEqual(t, snapshot.Size(), array.Size())
+	if array.Capacity() <= array.Size() {
+		t.Errorf("capacity '%d' is not greater than length '%d'", array.Capacity(), array.Size())
+	}
+}
@@ -2,12 +2,11 @@ package dynamicarray
 
 import (
 	"errors"
+	"math"
+	"reflect"
 )
 
-const (
-	defaultCapacity int32 = 1 << 2
-	maxCapacity     int32 = 1 << 10
-)
+const defaultCapacity int32 = 1 << 2
 
 var (
 	// ErrorIndexOutOfRange will be returned if a given index is out of range a container.
@@ -17,119 +16,292 @@ var (
 	ErrorElementNotFound = errors.New("there is no a needed element in an array")
 
 	// ErrorExceededCapacity will be returned if a given capacity is out of range.
-	ErrorExceededCapacity = errors.New("capacity size should be between 1 and < 1024")
+	ErrorExceededCapacity = errors.New("capacity size should be > 0 and should not exceed a maximum capacity")
 )
 
 // Array is an ADT.
-type Array interface {
-	Add(int32) error
+type Array[T any] interface {
+	Add(T) error
 	Capacity() int32
-	Find(interface{}) (int32, error)
-	Get(int32) (interface{}, error)
+	Clear()
+	Contains(T) bool
+	Find(T) (int32, error)
+	Get(int32) (T, error)
+	InsertAt(int32, T) error
+	Remove(T) error
 	RemoveAt(int32) error
 	Reverse()
-	Set(int32, interface{}) error
+	Set(int32, T) error
 	Size() int32
 }
 
+var _ Array[int] = (*DynamicArray[int])(nil)
+
 // DynamicArray is an implementation of a dynamic array using "static" arrays.
-type DynamicArray struct {
-	container []interface{}
-	length    int32
-	capacity  int32
+type DynamicArray[T any] struct {
+	container   []T
+	length      int32
+	capacity    int32
+	minCapacity int32
+	maxCapacity int32
+	growth      GrowthPolicy
+	// shared is set when storage is shared with a view or its original array,
+	// so it has to be copied before elements are moved or cleared.
+	shared bool
 }
 
 // Add appends an element to the end of an array. In case, there is insufficient
-// capacity it will double a capacity of an array to keep append time complexity
-// constant.
-func (d *DynamicArray) Add(element interface{}) error {
-	if d.length+1 >= d.Capacity() {
-		if err := d.resize(); err != nil {
-			return err
-		}
+// capacity it will grow a capacity of an array according to its growth policy
+// to keep amortized append time complexity constant.
+func (d *DynamicArray[T]) Add(element T) error {
+	if err := d.grow(int64(d.length) + 1); err != nil {
+		return err
 	}
 	d.container[d.length] = element
 	d.length++
 	return nil
 }
 
-func (d *DynamicArray) resize() error {
-	// If capacity is already set to maximum, we can't grow further
-	if d.Capacity() == maxCapacity {
+// AddAll appends elements to the end of an array. An array grows at most once,
+// and nothing is added if there is insufficient capacity for all the elements.
+func (d *DynamicArray[T]) AddAll(elements ...T) error {
+	if err := d.grow(int64(d.length) + int64(len(elements))); err != nil {
+		return err
+	}
+	copy(d.container[d.length:], elements)
+	d.length += int32(len(elements))
+	return nil
+}
+
+// grow makes sure a capacity of an array is greater than a given length, or
+// equal to it if it is the maximum capacity. The final capacity is computed up
+// front, so an array is reallocated at most once.
+func (d *DynamicArray[T]) grow(length int64) error {
+	if length < int64(d.Capacity()) {
+		return nil
+	}
+
+	// A maximum capacity is inclusive, an array can't grow beyond it
+	limit := d.limit()
+	if length > int64(limit) {
 		return ErrorExceededCapacity
 	}
 
-	// If capacity is less than a half of maxCapacity value, then double it.
-	// Otherwise, set to maxCapacity.
-	if d.Capacity() < (maxCapacity >> 1) {
-		d.capacity <<= 1
-	} else {
-		d.capacity = maxCapacity
+	growth := d.growth
+	if growth == nil {
+		growth = DoublingGrowth
 	}
 
-	var tempContainer = make([]interface{}, d.Capacity())
-	copy(tempContainer, d.container)
-	d.container = tempContainer
+	// A policy has to make progress, and it can't go beyond the limit.
+	capacity := d.Capacity()
+	for int64(capacity) <= length && capacity < limit {
+		next := growth(capacity)
+		if next <= capacity {
+			next = capacity + 1
+		}
+		if next > limit {
+			next = limit
+		}
+		capacity = next
+	}
+
+	if capacity != d.Capacity() {
+		d.reallocate(capacity)
+	}
 	return nil
 }
 
+// shrink halves a capacity while an array is less than a quarter full, but never
+// goes below the capacity the array has been created with.
+func (d *DynamicArray[T]) shrink() {
+	capacity := d.Capacity()
+	for capacity>>1 >= d.minCapacity && d.length < capacity>>2 {
+		capacity >>= 1
+	}
+	if capacity != d.Capacity() {
+		d.reallocate(capacity)
+	}
+}
+
+func (d *DynamicArray[T]) reallocate(capacity int32) {
+	var tempContainer = make([]T, capacity)
+	copy(tempContainer, d.container[:d.length])
+	d.container = tempContainer
+	d.capacity = capacity
+	d.shared = false
+}
+
+// unshare copies elements into storage of their own if an array shares it with
+// another one, so moving or clearing elements isn't visible in the other array.
+func (d *DynamicArray[T]) unshare() {
+	if d.shared {
+		d.reallocate(d.capacity)
+	}
+}
+
+func (d *DynamicArray[T]) limit() int32 {
+	if d.maxCapacity > 0 {
+		return d.maxCapacity
+	}
+	return math.MaxInt32
+}
+
 // Capacity returns a number of elements in an array.
-func (d *DynamicArray) Capacity() int32 {
+func (d *DynamicArray[T]) Capacity() int32 {
 	return d.capacity
 }
 
-// Find performs linear search on an array.
-func (d *DynamicArray) Find(element interface{}) (int32, error) {
-	for index, value := range d.container {
-		if element == value {
-			return int32(index), nil
+// Clear removes all elements from an array keeping its capacity.
+func (d *DynamicArray[T]) Clear() {
+	d.unshare()
+	clear(d.container[:d.length])
+	d.length = 0
+}
+
+// Contains reports whether an element is present in an array.
+func (d *DynamicArray[T]) Contains(element T) bool {
+	_, err := d.Find(element)
+	return err == nil
+}
+
+// Find performs linear search on an array. Elements are compared with == when
+// T is comparable, and structurally otherwise. Use FindFunc for a custom
+// equality or FindComparable to avoid boxing when T is statically comparable.
+func (d *DynamicArray[T]) Find(element T) (int32, error) {
+	equal := defaultEqual[T]()
+	return d.FindFunc(func(value T) bool {
+		return equal(value, element)
+	})
+}
+
+// defaultEqual picks an equality for values of T. Interfaces may hold values that
+// can't be compared with ==, so types containing them are compared structurally.
+func defaultEqual[T any]() func(a, b T) bool {
+	if safelyComparable(reflect.TypeFor[T]()) {
+		return func(a, b T) bool {
+			return any(a) == any(b)
+		}
+	}
+	return func(a, b T) bool {
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// safelyComparable reports whether == never panics on values of a type. Structs
+// and arrays are comparable even if they hold interfaces, while == panics if
+// such an interface holds e.g. a slice, so fields and elements are checked too.
+func safelyComparable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return safelyComparable(t.Elem())
+	case reflect.Struct:
+		for index := 0; index < t.NumField(); index++ {
+			if !safelyComparable(t.Field(index).Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return t.Comparable()
+}
+
+// FindFunc performs linear search on an array and returns an index of the first
+// element satisfying a given predicate.
+func (d *DynamicArray[T]) FindFunc(match func(T) bool) (int32, error) {
+	for index := int32(0); index < d.length; index++ {
+		if match(d.container[index]) {
+			return index, nil
+		}
+	}
+	return 0, ErrorElementNotFound
+}
+
+// FindComparable performs linear search on an array of comparable elements.
+// Unlike DynamicArray.Find, it compares elements directly without boxing them.
+func FindComparable[T comparable](d *DynamicArray[T], element T) (int32, error) {
+	for index := int32(0); index < d.length; index++ {
+		if d.container[index] == element {
+			return index, nil
 		}
 	}
 	return 0, ErrorElementNotFound
 }
 
 // Get returns an element by a given index.
-func (d *DynamicArray) Get(index int32) (interface{}, error) {
+func (d *DynamicArray[T]) Get(index int32) (T, error) {
 	if err := d.checkIndex(index); err != nil {
-		return nil, err
+		var zero T
+		return zero, err
 	}
 	return d.container[index], nil
 }
 
-func (d *DynamicArray) checkIndex(index int32) error {
+// InsertAt puts an element at a given index shifting the following elements to
+// the right. An index equal to the size of an array appends the element.
+func (d *DynamicArray[T]) InsertAt(index int32, element T) error {
+	if index != d.length {
+		if err := d.checkIndex(index); err != nil {
+			return err
+		}
+	}
+	if err := d.grow(int64(d.length) + 1); err != nil {
+		return err
+	}
+	d.unshare()
+	copy(d.container[index+1:d.length+1], d.container[index:d.length])
+	d.container[index] = element
+	d.length++
+	return nil
+}
+
+func (d *DynamicArray[T]) checkIndex(index int32) error {
 	if index >= d.length || index < 0 {
 		return ErrorIndexOutOfRange
 	}
 	return nil
 }
 
-// RemoveAt a given index.
-func (d *DynamicArray) RemoveAt(index int32) error {
+// Remove deletes the first occurrence of an element from an array.
+func (d *DynamicArray[T]) Remove(element T) error {
+	index, err := d.Find(element)
+	if err != nil {
+		return err
+	}
+	return d.RemoveAt(index)
+}
+
+// RemoveAt a given index. When an array becomes less than a quarter full,
+// its capacity is halved.
+func (d *DynamicArray[T]) RemoveAt(index int32) error {
 	if err := d.checkIndex(index); err != nil {
 		return err
 	}
-	newPosition := 0
-	for oldPosition := 0; oldPosition < int(d.length); oldPosition++ {
-		if oldPosition == int(index) {
-			continue
-		}
-		d.container[newPosition] = d.container[oldPosition]
-		newPosition++
+	return d.RemoveRange(index, index+1)
+}
+
+// RemoveRange deletes elements in a half-open range [from, to) shifting the
+// following elements to the left in one pass.
+func (d *DynamicArray[T]) RemoveRange(from, to int32) error {
+	if from < 0 || from > to || to > d.length {
+		return ErrorIndexOutOfRange
 	}
-	d.container[d.length-1] = nil
-	d.length--
-	return nil
+	d.unshare()
+	copy(d.container[from:], d.container[to:d.length])
+	return d.Truncate(d.length - (to - from))
 }
 
 // Reverse an array using two pointers approach.
-func (d *DynamicArray) Reverse() {
+func (d *DynamicArray[T]) Reverse() {
+	d.unshare()
 	for i, j := 0, int(d.length)-1; i < j; i, j = i+1, j-1 {
 		d.container[i], d.container[j] = d.container[j], d.container[i]
 	}
 }
 
 // Set an element by a given index.
-func (d *DynamicArray) Set(index int32, value interface{}) error {
+func (d *DynamicArray[T]) Set(index int32, value T) error {
 	if err := d.checkIndex(index); err != nil {
 		return err
 	}
@@ -137,35 +309,57 @@ func (d *DynamicArray) Set(index int32, value interface{}) error {
 	return nil
 }
 
+// Truncate keeps the first n elements of an array and discards the rest.
+func (d *DynamicArray[T]) Truncate(n int32) error {
+	if n < 0 || n > d.length {
+		return ErrorIndexOutOfRange
+	}
+	d.unshare()
+	clear(d.container[n:d.length])
+	d.length = n
+	d.shrink()
+	return nil
+}
+
 // Size returns a number of elements in an array.
-func (d *DynamicArray) Size() int32 {
+func (d *DynamicArray[T]) Size() int32 {
 	return d.length
 }
 
-// NewDynamicArray creates a new dynamic array with default capacity = 4.
-func NewDynamicArray() *DynamicArray {
-	return &DynamicArray{
-		container: make([]interface{}, defaultCapacity),
-		length:    0,
-		capacity:  defaultCapacity,
+// NewDynamicArray creates a new unbounded dynamic array with default capacity = 4,
+// which doubles when an array is full. Both can be changed with options.
+func NewDynamicArray[T any](options ...Option) *DynamicArray[T] {
+	cfg := newConfig(options)
+	capacity := defaultCapacity
+	if cfg.maxCapacity > 0 && cfg.maxCapacity < capacity {
+		capacity = cfg.maxCapacity
 	}
+	return newDynamicArray[T](capacity, cfg)
 }
 
 // NewDynamicArrayWithCapacity creates a new dynamic array with provided capacity
-// that should not exceed MaxInt32 value, and be more than 0.
-func NewDynamicArrayWithCapacity(capacity int32) (*DynamicArray, error) {
-	if err := checkCapacity(capacity); err != nil {
+// that should be more than 0 and should not exceed a maximum capacity if one is set.
+func NewDynamicArrayWithCapacity[T any](capacity int32, options ...Option) (*DynamicArray[T], error) {
+	cfg := newConfig(options)
+	if err := checkCapacity(capacity, cfg.maxCapacity); err != nil {
 		return nil, err
 	}
-	return &DynamicArray{
-		container: make([]interface{}, capacity),
-		length:    0,
-		capacity:  capacity,
-	}, nil
+	return newDynamicArray[T](capacity, cfg), nil
+}
+
+func newDynamicArray[T any](capacity int32, cfg *config) *DynamicArray[T] {
+	return &DynamicArray[T]{
+		container:   make([]T, capacity),
+		length:      0,
+		capacity:    capacity,
+		minCapacity: capacity,
+		maxCapacity: cfg.maxCapacity,
+		growth:      cfg.growth,
+	}
 }
 
-func checkCapacity(capacity int32) error {
-	if capacity <= 0 || capacity >= maxCapacity {
+func checkCapacity(capacity, maxCapacity int32) error {
+	if capacity <= 0 || (maxCapacity > 0 && capacity > maxCapacity) {
 		return ErrorExceededCapacity
 	}
 	return nil
@@ -2,7 +2,21 @@ package dynamicarray
 
 import "testing"
 
-func assertCorrectCapacity(t *testing.T, array *DynamicArray, expected int32) {
+const testMaxCapacity int32 = 1 << 10
+
+type point struct {
+	x, y int
+}
+
+func intElement(i int) int {
+	return i
+}
+
+func pointElement(i int) point {
+	return point{x: i, y: -i}
+}
+
+func assertCorrectCapacity[T any](t *testing.T, array *DynamicArray[T], expected int32) {
 	t.Helper()
 	actual := array.Capacity()
 	if actual != expected {
@@ -10,7 +24,7 @@ func assertCorrectCapacity(t *testing.T, array *DynamicArray, expected int32) {
 	}
 }
 
-func assertCorrectLength(t *testing.T, array *DynamicArray, expected int32) {
+func assertCorrectLength[T any](t *testing.T, array *DynamicArray[T], expected int32) {
 	t.Helper()
 	actual := array.Size()
 	if actual != expected {
@@ -25,15 +39,15 @@ func assertError(t *testing.T, actual, expected error) {
 	}
 }
 
-func assertEqual(t *testing.T, actual, expected interface{}) {
+func assertEqual[T comparable](t *testing.T, actual, expected T) {
 	t.Helper()
 	if actual != expected {
-		t.Errorf("expected value '%d', but got '%d'", expected, actual)
+		t.Errorf("expected value '%v', but got '%v'", expected, actual)
 	}
 }
 
 func TestNewDynamicArray(t *testing.T) {
-	array := NewDynamicArray()
+	array := NewDynamicArray[int]()
 
 	assertCorrectLength(t, array, 0)
 	assertCorrectCapacity(t, array, defaultCapacity)
@@ -41,19 +55,26 @@ func TestNewDynamicArray(t *testing.T) {
 
 func TestNewDynamicArrayWithCapacity(t *testing.T) {
 	t.Run("initialize an array with negative capacity", func(t *testing.T) {
-		_, err := NewDynamicArrayWithCapacity(-10)
+		_, err := NewDynamicArrayWithCapacity[int](-10)
 
 		assertError(t, err, ErrorExceededCapacity)
 	})
 
 	t.Run("initialize an array with exceeded capacity", func(t *testing.T) {
-		_, err := NewDynamicArrayWithCapacity(maxCapacity)
+		_, err := NewDynamicArrayWithCapacity[int](testMaxCapacity+1, WithMaxCapacity(testMaxCapacity))
 
 		assertError(t, err, ErrorExceededCapacity)
 	})
 
+	t.Run("initialize an array with a large capacity", func(t *testing.T) {
+		array, err := NewDynamicArrayWithCapacity[int](testMaxCapacity << 2)
+
+		assertCorrectCapacity(t, array, testMaxCapacity<<2)
+		assertError(t, err, nil)
+	})
+
 	t.Run("initialize an array with allowed capacity", func(t *testing.T) {
-		array, err := NewDynamicArrayWithCapacity(10)
+		array, err := NewDynamicArrayWithCapacity[point](10)
 
 		assertCorrectLength(t, array, int32(0))
 		assertCorrectCapacity(t, array, 10)
@@ -62,97 +83,290 @@ func TestNewDynamicArrayWithCapacity(t *testing.T) {
 }
 
 func TestAddElementsToArray(t *testing.T) {
+	t.Run("int", func(t *testing.T) { testAddElementsToArray(t, intElement) })
+	t.Run("struct", func(t *testing.T) { testAddElementsToArray(t, pointElement) })
+}
+
+func testAddElementsToArray[T comparable](t *testing.T, element func(int) T) {
 	t.Run("add one element to array should increase length", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
-		err := array.Add(10)
+		err := array.Add(element(10))
 
 		assertError(t, err, nil)
 		assertCorrectLength(t, array, 1)
 
-		element, err := array.Get(0)
+		actual, err := array.Get(0)
 
 		assertError(t, err, nil)
-		assertEqual(t, element, 10)
+		assertEqual(t, actual, element(10))
 	})
 
 	t.Run("add 4 elements should double array's capacity", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
 		for i := 1; i <= 4; i++ {
-			_ = array.Add(i)
+			_ = array.Add(element(i))
 		}
 
 		assertCorrectCapacity(t, array, defaultCapacity<<1)
 	})
 
 	t.Run("exceed maximum number of elements increase capacity", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T](WithMaxCapacity(testMaxCapacity))
 
-		for i := 1; i <= int(maxCapacity); i++ {
-			_ = array.Add(i)
+		for i := 1; i <= int(testMaxCapacity); i++ {
+			_ = array.Add(element(i))
 		}
 
-		assertCorrectCapacity(t, array, maxCapacity)
+		assertCorrectCapacity(t, array, testMaxCapacity)
 
-		err := array.Add(1)
+		err := array.Add(element(1))
 		assertError(t, err, ErrorExceededCapacity)
 	})
+
+	t.Run("an array is unbounded by default", func(t *testing.T) {
+		array := NewDynamicArray[T]()
+
+		for i := 1; i <= int(testMaxCapacity)<<2; i++ {
+			if err := array.Add(element(i)); err != nil {
+				t.Fatalf("unexpected error on add: %s", err)
+			}
+		}
+
+		assertCorrectLength(t, array, testMaxCapacity<<2)
+		actual, _ := array.Get(testMaxCapacity<<2 - 1)
+		assertEqual(t, actual, element(int(testMaxCapacity)<<2))
+	})
+}
+
+func TestGrowthPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   GrowthPolicy
+		expected []int32
+	}{
+		{name: "doubling", policy: DoublingGrowth, expected: []int32{4, 8, 16, 32}},
+		{name: "one and a half", policy: OneAndHalfGrowth, expected: []int32{4, 6, 9, 13}},
+		{name: "fixed increment", policy: IncrementGrowth(3), expected: []int32{4, 7, 10, 13}},
+		{name: "policy without progress", policy: IncrementGrowth(0), expected: []int32{4, 5, 6, 7}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			array := NewDynamicArray[int](WithGrowthPolicy(test.policy))
+
+			capacities := []int32{array.Capacity()}
+			for i := 0; len(capacities) < len(test.expected); i++ {
+				_ = array.Add(i)
+				if capacity := array.Capacity(); capacity != capacities[len(capacities)-1] {
+					capacities = append(capacities, capacity)
+				}
+			}
+
+			for index, expected := range test.expected {
+				assertEqual(t, capacities[index], expected)
+			}
+		})
+	}
+
+	t.Run("growth stops at a maximum capacity", func(t *testing.T) {
+		array := NewDynamicArray[int](WithGrowthPolicy(DoublingGrowth), WithMaxCapacity(6))
+
+		for i := 0; i < 6; i++ {
+			assertError(t, array.Add(i), nil)
+		}
+
+		assertCorrectCapacity(t, array, 6)
+		assertError(t, array.Add(6), ErrorExceededCapacity)
+		assertCorrectLength(t, array, 6)
+	})
+
+	t.Run("a maximum capacity of one holds an element", func(t *testing.T) {
+		array := NewDynamicArray[int](WithMaxCapacity(1))
+
+		assertError(t, array.Add(1), nil)
+		assertError(t, array.Add(2), ErrorExceededCapacity)
+		assertElements(t, array, 1)
+	})
+
+	t.Run("saturate on overflow", func(t *testing.T) {
+		assertEqual(t, DoublingGrowth(1<<30), int32(1<<31-1))
+		assertEqual(t, OneAndHalfGrowth(1<<30+1<<29), int32(1<<31-1))
+		assertEqual(t, IncrementGrowth(10)(1<<31-5), int32(1<<31-1))
+	})
+}
+
+func TestShrink(t *testing.T) {
+	t.Run("remove shrinks an array that is less than a quarter full", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+		for i := 0; i < 64; i++ {
+			_ = array.Add(i)
+		}
+		grown := array.Capacity()
+
+		for array.Size() > 4 {
+			_ = array.RemoveAt(0)
+		}
+
+		if array.Capacity() >= grown {
+			t.Errorf("expected capacity to shrink from '%d', but got '%d'", grown, array.Capacity())
+		}
+		if array.Size()<<2 < array.Capacity()>>1 {
+			t.Errorf("capacity '%d' is too large for length '%d'", array.Capacity(), array.Size())
+		}
+		for i := int32(0); i < array.Size(); i++ {
+			actual, _ := array.Get(i)
+			assertEqual(t, actual, int(60+i))
+		}
+	})
+
+	t.Run("an array doesn't shrink below its initial capacity", func(t *testing.T) {
+		array, _ := NewDynamicArrayWithCapacity[int](32)
+		for i := 0; i < 100; i++ {
+			_ = array.Add(i)
+		}
+
+		for array.Size() > 0 {
+			_ = array.RemoveAt(array.Size() - 1)
+		}
+
+		assertCorrectCapacity(t, array, 32)
+	})
 }
 
 func TestFind(t *testing.T) {
+	t.Run("int", func(t *testing.T) { testFind(t, intElement) })
+	t.Run("struct", func(t *testing.T) { testFind(t, pointElement) })
+}
+
+func TestFindUncomparable(t *testing.T) {
+	t.Run("slices", func(t *testing.T) {
+		array := NewDynamicArray[[]int]()
+		_ = array.AddAll([]int{1}, []int{2, 3})
+
+		index, err := array.Find([]int{2, 3})
+
+		assertError(t, err, nil)
+		assertEqual(t, index, int32(1))
+		assertEqual(t, array.Contains([]int{1}), true)
+		assertEqual(t, array.Contains([]int{4}), false)
+		assertError(t, array.Remove([]int{1}), nil)
+		assertEqual(t, array.Size(), int32(1))
+	})
+
+	t.Run("structs holding interfaces", func(t *testing.T) {
+		type boxed struct{ v any }
+		array := NewDynamicArray[boxed]()
+		_ = array.AddAll(boxed{1}, boxed{[]int{1}})
+
+		index, err := array.Find(boxed{[]int{1}})
+
+		assertError(t, err, nil)
+		assertEqual(t, index, int32(1))
+	})
+}
+
+func testFind[T comparable](t *testing.T, element func(int) T) {
 	t.Run("find in an empty array", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
-		_, err := array.Find(10)
+		_, err := array.Find(element(10))
+
+		assertError(t, err, ErrorElementNotFound)
+	})
+
+	t.Run("find a zero value in an empty array", func(t *testing.T) {
+		array := NewDynamicArray[T]()
+
+		var zero T
+		_, err := array.Find(zero)
 
 		assertError(t, err, ErrorElementNotFound)
 	})
 
 	t.Run("find not existed element's index", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
-		_ = array.Add(11)
-		_, err := array.Find(10)
+		_ = array.Add(element(11))
+		_, err := array.Find(element(10))
 
 		assertError(t, err, ErrorElementNotFound)
 	})
 
 	t.Run("find existed element's index", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
-		_ = array.Add(11)
-		_ = array.Add(10)
-		actual, err := array.Find(10)
+		_ = array.Add(element(11))
+		_ = array.Add(element(10))
+		actual, err := array.Find(element(10))
 
 		assertEqual(t, actual, int32(1))
 		assertError(t, err, nil)
 	})
+
+	t.Run("find existed element's index with a predicate", func(t *testing.T) {
+		array := NewDynamicArray[T]()
+
+		_ = array.Add(element(11))
+		_ = array.Add(element(10))
+		actual, err := array.FindFunc(func(value T) bool { return value == element(10) })
+
+		assertEqual(t, actual, int32(1))
+		assertError(t, err, nil)
+	})
+
+	t.Run("find existed element's index in a comparable array", func(t *testing.T) {
+		array := NewDynamicArray[T]()
+
+		_ = array.Add(element(11))
+		_ = array.Add(element(10))
+		actual, err := FindComparable(array, element(10))
+
+		assertEqual(t, actual, int32(1))
+		assertError(t, err, nil)
+
+		_, err = FindComparable(array, element(12))
+
+		assertError(t, err, ErrorElementNotFound)
+	})
 }
 
 func TestGet(t *testing.T) {
+	t.Run("int", func(t *testing.T) { testGet(t, intElement) })
+	t.Run("struct", func(t *testing.T) { testGet(t, pointElement) })
+}
+
+func testGet[T comparable](t *testing.T, element func(int) T) {
 	t.Run("get an element out of array's bounds", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
-		_, err := array.Get(100)
+		actual, err := array.Get(100)
 
+		var zero T
+		assertEqual(t, actual, zero)
 		assertError(t, err, ErrorIndexOutOfRange)
 	})
 
 	t.Run("get an element by an existing index", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
-		_ = array.Add(10)
+		_ = array.Add(element(10))
 		actual, err := array.Get(0)
 
-		assertEqual(t, actual, 10)
+		assertEqual(t, actual, element(10))
 		assertError(t, err, nil)
 	})
 }
 
 func TestReverse(t *testing.T) {
+	t.Run("int", func(t *testing.T) { testReverse(t, intElement) })
+	t.Run("struct", func(t *testing.T) { testReverse(t, pointElement) })
+}
+
+func testReverse[T comparable](t *testing.T, element func(int) T) {
 	t.Run("reverse an empty array", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
 		array.Reverse()
 
@@ -160,10 +374,10 @@ func TestReverse(t *testing.T) {
 	})
 
 	t.Run("get an element by an existing index", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
 		for i := 0; i < 10; i++ {
-			_ = array.Add(i)
+			_ = array.Add(element(i))
 		}
 		firstElement, _ := array.Get(0)
 		array.Reverse()
@@ -174,22 +388,32 @@ func TestReverse(t *testing.T) {
 }
 
 func TestSet(t *testing.T) {
-	array := NewDynamicArray()
+	t.Run("int", func(t *testing.T) { testSet(t, intElement) })
+	t.Run("struct", func(t *testing.T) { testSet(t, pointElement) })
+}
+
+func testSet[T comparable](t *testing.T, element func(int) T) {
+	array := NewDynamicArray[T]()
 
-	_ = array.Add(10)
+	_ = array.Add(element(10))
 	oldValue, _ := array.Get(0)
-	_ = array.Set(0, 15)
+	_ = array.Set(0, element(15))
 	newValue, _ := array.Get(0)
-	err := array.Set(100, 1337)
+	err := array.Set(100, element(1337))
 
-	assertEqual(t, oldValue, 10)
-	assertEqual(t, newValue, 15)
+	assertEqual(t, oldValue, element(10))
+	assertEqual(t, newValue, element(15))
 	assertError(t, err, ErrorIndexOutOfRange)
 }
 
 func TestRemove(t *testing.T) {
+	t.Run("int", func(t *testing.T) { testRemove(t, intElement) })
+	t.Run("struct", func(t *testing.T) { testRemove(t, pointElement) })
+}
+
+func testRemove[T comparable](t *testing.T, element func(int) T) {
 	t.Run("remove out of array's range", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
 		err := array.RemoveAt(10)
 
@@ -197,9 +421,9 @@ func TestRemove(t *testing.T) {
 	})
 
 	t.Run("remove from array's beginning", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
-		_ = array.Add(10)
+		_ = array.Add(element(10))
 		err := array.RemoveAt(0)
 
 		assertError(t, err, nil)
@@ -207,14 +431,163 @@ func TestRemove(t *testing.T) {
 	})
 
 	t.Run("remove from array's middle", func(t *testing.T) {
-		array := NewDynamicArray()
+		array := NewDynamicArray[T]()
 
-		_ = array.Add(10)
-		_ = array.Add(10)
-		_ = array.Add(10)
+		_ = array.Add(element(10))
+		_ = array.Add(element(11))
+		_ = array.Add(element(12))
 		err := array.RemoveAt(1)
 
 		assertCorrectLength(t, array, 2)
 		assertError(t, err, nil)
+
+		actual, _ := array.Get(1)
+		assertEqual(t, actual, element(12))
+	})
+}
+
+func assertElements[T comparable](t *testing.T, array *DynamicArray[T], expected ...T) {
+	t.Helper()
+	assertCorrectLength(t, array, int32(len(expected)))
+	for index, value := range expected {
+		actual, _ := array.Get(int32(index))
+		assertEqual(t, actual, value)
+	}
+}
+
+func TestAddAll(t *testing.T) {
+	t.Run("add all elements to the end of an array", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+		_ = array.Add(1)
+
+		err := array.AddAll(2, 3, 4, 5, 6)
+
+		assertError(t, err, nil)
+		assertElements(t, array, 1, 2, 3, 4, 5, 6)
+	})
+
+	t.Run("add all grows an array at most once", func(t *testing.T) {
+		values := make([]int, 100)
+		base := testing.AllocsPerRun(10, func() {
+			_ = NewDynamicArray[int]()
+		})
+		bulk := testing.AllocsPerRun(10, func() {
+			_ = NewDynamicArray[int]().AddAll(values...)
+		})
+
+		assertEqual(t, bulk-base, float64(1))
+	})
+
+	t.Run("add all is atomic when capacity is exceeded", func(t *testing.T) {
+		array := NewDynamicArray[int](WithMaxCapacity(8))
+		_ = array.AddAll(1, 2, 3)
+
+		err := array.AddAll(4, 5, 6, 7, 8, 9)
+
+		assertError(t, err, ErrorExceededCapacity)
+		assertElements(t, array, 1, 2, 3)
+
+		err = array.AddAll(4, 5, 6, 7, 8)
+
+		assertError(t, err, nil)
+		assertElements(t, array, 1, 2, 3, 4, 5, 6, 7, 8)
+	})
+}
+
+func TestInsertAt(t *testing.T) {
+	t.Run("insert shifts elements and grows an array", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+
+		for i := 0; i < 10; i++ {
+			_ = array.InsertAt(0, i)
+		}
+
+		assertElements(t, array, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0)
+	})
+
+	t.Run("insert into a full array", func(t *testing.T) {
+		array := NewDynamicArray[int](WithMaxCapacity(2))
+		_ = array.Add(1)
+
+		err := array.InsertAt(0, 0)
+
+		assertError(t, err, nil)
+		assertElements(t, array, 0, 1)
+
+		err = array.InsertAt(1, 2)
+
+		assertError(t, err, ErrorExceededCapacity)
+		assertElements(t, array, 0, 1)
+	})
+}
+
+func TestRemoveRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to int32
+		err      error
+		expected []int
+	}{
+		{name: "remove from the beginning", from: 0, to: 2, expected: []int{3, 4, 5}},
+		{name: "remove from the middle", from: 1, to: 4, expected: []int{1, 5}},
+		{name: "remove up to the end", from: 3, to: 5, expected: []int{1, 2, 3}},
+		{name: "remove an empty range", from: 2, to: 2, expected: []int{1, 2, 3, 4, 5}},
+		{name: "remove everything", from: 0, to: 5, expected: []int{}},
+		{name: "negative from", from: -1, to: 2, err: ErrorIndexOutOfRange, expected: []int{1, 2, 3, 4, 5}},
+		{name: "from after to", from: 3, to: 2, err: ErrorIndexOutOfRange, expected: []int{1, 2, 3, 4, 5}},
+		{name: "to beyond length", from: 3, to: 6, err: ErrorIndexOutOfRange, expected: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			array := NewDynamicArray[int]()
+			_ = array.AddAll(1, 2, 3, 4, 5)
+
+			err := array.RemoveRange(test.from, test.to)
+
+			assertError(t, err, test.err)
+			assertElements(t, array, test.expected...)
+		})
+	}
+
+	t.Run("removed slots are cleared", func(t *testing.T) {
+		array := NewDynamicArray[*int]()
+		one, two := 1, 2
+		_ = array.AddAll(&one, &two, &one)
+
+		_ = array.RemoveRange(0, 2)
+
+		assertEqual(t, array.container[1], nil)
+		assertEqual(t, array.container[2], nil)
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	t.Run("truncate keeps the first elements", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+		_ = array.AddAll(1, 2, 3, 4, 5)
+
+		err := array.Truncate(2)
+
+		assertError(t, err, nil)
+		assertElements(t, array, 1, 2)
+	})
+
+	t.Run("truncate out of range", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+		_ = array.AddAll(1, 2, 3)
+
+		assertError(t, array.Truncate(4), ErrorIndexOutOfRange)
+		assertError(t, array.Truncate(-1), ErrorIndexOutOfRange)
+		assertElements(t, array, 1, 2, 3)
+	})
+
+	t.Run("truncate shrinks an array", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+		_ = array.AddAll(make([]int, 100)...)
+
+		_ = array.Truncate(1)
+
+		assertCorrectCapacity(t, array, defaultCapacity)
 	})
 }
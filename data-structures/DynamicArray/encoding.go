@@ -0,0 +1,77 @@
+package dynamicarray
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+)
+
+var (
+	_ json.Marshaler             = (*DynamicArray[int])(nil)
+	_ json.Unmarshaler           = (*DynamicArray[int])(nil)
+	_ encoding.BinaryMarshaler   = (*DynamicArray[int])(nil)
+	_ encoding.BinaryUnmarshaler = (*DynamicArray[int])(nil)
+	_ gob.GobEncoder             = (*DynamicArray[int])(nil)
+	_ gob.GobDecoder             = (*DynamicArray[int])(nil)
+)
+
+// MarshalJSON encodes elements of an array as a JSON array. Unused capacity
+// isn't encoded.
+func (d *DynamicArray[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.ToSlice())
+}
+
+// UnmarshalJSON replaces elements of an array with elements of a JSON array.
+// An array keeps its settings, so it returns ErrorExceededCapacity if the
+// elements don't fit into a maximum capacity.
+func (d *DynamicArray[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	return d.replace(elements)
+}
+
+// MarshalBinary encodes elements of an array with encoding/gob. Unused capacity
+// isn't encoded.
+func (d *DynamicArray[T]) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(d.ToSlice()); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary replaces elements of an array with elements encoded by
+// MarshalBinary. An array keeps its settings, so it returns ErrorExceededCapacity
+// if the elements don't fit into a maximum capacity.
+func (d *DynamicArray[T]) UnmarshalBinary(data []byte) error {
+	var elements []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements); err != nil {
+		return err
+	}
+	return d.replace(elements)
+}
+
+// GobEncode encodes an array the same way MarshalBinary does.
+func (d *DynamicArray[T]) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode decodes an array the same way UnmarshalBinary does.
+func (d *DynamicArray[T]) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// replace swaps elements of an array with given ones. An array is left intact
+// if the elements don't fit into it.
+func (d *DynamicArray[T]) replace(elements []T) error {
+	if int64(len(elements)) > int64(d.limit()) {
+		return ErrorExceededCapacity
+	}
+	if err := d.Truncate(0); err != nil {
+		return err
+	}
+	return d.AddAll(elements...)
+}
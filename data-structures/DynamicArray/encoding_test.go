@@ -0,0 +1,167 @@
+package dynamicarray
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+type coordinate struct {
+	X, Y int
+}
+
+func TestJSON(t *testing.T) {
+	t.Run("round trip of ints", func(t *testing.T) {
+		array, _ := FromSlice([]int{3, 1, 2})
+
+		data, err := json.Marshal(array)
+		assertError(t, err, nil)
+		assertEqual(t, string(data), "[3,1,2]")
+
+		decoded := NewDynamicArray[int]()
+		err = json.Unmarshal(data, decoded)
+
+		assertError(t, err, nil)
+		assertEqual(t, decoded.Equal(array), true)
+	})
+
+	t.Run("round trip of structs", func(t *testing.T) {
+		array, _ := FromSlice([]coordinate{{X: 1, Y: 2}, {X: 3, Y: 4}})
+
+		data, err := json.Marshal(array)
+		assertError(t, err, nil)
+
+		var decoded DynamicArray[coordinate]
+		err = json.Unmarshal(data, &decoded)
+
+		assertError(t, err, nil)
+		assertElements(t, &decoded, coordinate{X: 1, Y: 2}, coordinate{X: 3, Y: 4})
+	})
+
+	t.Run("an empty array", func(t *testing.T) {
+		data, err := json.Marshal(NewDynamicArray[int]())
+
+		assertError(t, err, nil)
+		assertEqual(t, string(data), "[]")
+	})
+
+	t.Run("unmarshal replaces elements", func(t *testing.T) {
+		array, _ := FromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+		err := json.Unmarshal([]byte("[10, 20]"), array)
+
+		assertError(t, err, nil)
+		assertElements(t, array, 10, 20)
+	})
+
+	t.Run("unmarshal beyond a maximum capacity", func(t *testing.T) {
+		array, _ := FromSlice([]int{1}, WithMaxCapacity(3))
+
+		err := json.Unmarshal([]byte("[1, 2, 3, 4]"), array)
+
+		assertError(t, err, ErrorExceededCapacity)
+		assertElements(t, array, 1)
+	})
+
+	t.Run("unmarshal up to a maximum capacity", func(t *testing.T) {
+		array, _ := FromSlice([]int{1}, WithMaxCapacity(3))
+
+		err := json.Unmarshal([]byte("[1, 2, 3]"), array)
+
+		assertError(t, err, nil)
+		assertElements(t, array, 1, 2, 3)
+	})
+
+	t.Run("unmarshal invalid data", func(t *testing.T) {
+		array, _ := FromSlice([]int{1})
+
+		err := json.Unmarshal([]byte(`["a"]`), array)
+
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+		assertElements(t, array, 1)
+	})
+}
+
+func TestBinary(t *testing.T) {
+	t.Run("round trip of ints", func(t *testing.T) {
+		array, _ := FromSlice([]int{3, 1, 2})
+
+		data, err := array.MarshalBinary()
+		assertError(t, err, nil)
+
+		decoded := NewDynamicArray[int]()
+		err = decoded.UnmarshalBinary(data)
+
+		assertError(t, err, nil)
+		assertElements(t, decoded, 3, 1, 2)
+	})
+
+	t.Run("round trip of structs", func(t *testing.T) {
+		array, _ := FromSlice([]coordinate{{X: 1, Y: 2}, {X: 3, Y: 4}})
+
+		data, err := array.MarshalBinary()
+		assertError(t, err, nil)
+
+		decoded := NewDynamicArray[coordinate]()
+		err = decoded.UnmarshalBinary(data)
+
+		assertError(t, err, nil)
+		assertElements(t, decoded, coordinate{X: 1, Y: 2}, coordinate{X: 3, Y: 4})
+	})
+
+	t.Run("round trip of an empty array", func(t *testing.T) {
+		data, err := NewDynamicArray[int]().MarshalBinary()
+		assertError(t, err, nil)
+
+		decoded, _ := FromSlice([]int{1})
+		err = decoded.UnmarshalBinary(data)
+
+		assertError(t, err, nil)
+		assertElements(t, decoded)
+	})
+
+	t.Run("unused capacity isn't encoded", func(t *testing.T) {
+		small, _ := FromSlice([]int{1, 2})
+		large, _ := NewDynamicArrayWithCapacity[int](1 << 10)
+		_ = large.AddAll(1, 2)
+
+		smallData, _ := small.MarshalBinary()
+		largeData, _ := large.MarshalBinary()
+
+		assertEqual(t, bytes.Equal(smallData, largeData), true)
+	})
+
+	t.Run("unmarshal invalid data", func(t *testing.T) {
+		array, _ := FromSlice([]int{1})
+
+		err := array.UnmarshalBinary([]byte("invalid"))
+
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+		assertElements(t, array, 1)
+	})
+}
+
+func TestGob(t *testing.T) {
+	type snapshot struct {
+		Name   string
+		Points *DynamicArray[coordinate]
+	}
+
+	points, _ := FromSlice([]coordinate{{X: 1, Y: 2}, {X: 3, Y: 4}})
+
+	var buffer bytes.Buffer
+	err := gob.NewEncoder(&buffer).Encode(snapshot{Name: "path", Points: points})
+	assertError(t, err, nil)
+
+	var decoded snapshot
+	err = gob.NewDecoder(&buffer).Decode(&decoded)
+
+	assertError(t, err, nil)
+	assertEqual(t, decoded.Name, "path")
+	assertEqual(t, decoded.Points.Equal(points), true)
+}
@@ -0,0 +1,93 @@
+package dynamicarray
+
+import "iter"
+
+// All returns an iterator over indexes and elements of an array from the first
+// element to the last one.
+func (d *DynamicArray[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index := int32(0); index < d.length; index++ {
+			if !yield(int(index), d.container[index]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over indexes and elements of an array from the
+// last element to the first one.
+func (d *DynamicArray[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index := d.length - 1; index >= 0; index-- {
+			if !yield(int(index), d.container[index]) {
+				return
+			}
+		}
+	}
+}
+
+// Each calls a function for every element of an array in order.
+func (d *DynamicArray[T]) Each(f func(index int, element T)) {
+	for index, element := range d.All() {
+		f(index, element)
+	}
+}
+
+// Map returns a new array with results of calling a function on every element
+// of a given array. The new array has the same capacity settings.
+func Map[T, U any](d *DynamicArray[T], f func(T) U) *DynamicArray[U] {
+	mapped := derive[U](d)
+	for index, element := range d.All() {
+		mapped.container[index] = f(element)
+	}
+	mapped.length = d.length
+	return mapped
+}
+
+// Filter returns a new array with elements of a given array that satisfy a
+// predicate. The new array has the same capacity settings.
+func Filter[T any](d *DynamicArray[T], keep func(T) bool) *DynamicArray[T] {
+	filtered := derive[T](d)
+	for _, element := range d.All() {
+		if keep(element) {
+			filtered.container[filtered.length] = element
+			filtered.length++
+		}
+	}
+	filtered.shrink()
+	return filtered
+}
+
+// Reduce folds elements of an array into a single value starting with initial.
+func Reduce[T, A any](d *DynamicArray[T], initial A, f func(accumulator A, element T) A) A {
+	accumulator := initial
+	for _, element := range d.All() {
+		accumulator = f(accumulator, element)
+	}
+	return accumulator
+}
+
+// Any reports whether at least one element of an array satisfies a predicate.
+func Any[T any](d *DynamicArray[T], match func(T) bool) bool {
+	_, err := d.FindFunc(match)
+	return err == nil
+}
+
+// Every reports whether all elements of an array satisfy a predicate. It's true
+// for an empty array.
+func Every[T any](d *DynamicArray[T], match func(T) bool) bool {
+	return !Any(d, func(element T) bool {
+		return !match(element)
+	})
+}
+
+// derive creates an empty array with the same capacity settings as d, which is
+// large enough to hold all elements of d.
+func derive[U, T any](d *DynamicArray[T]) *DynamicArray[U] {
+	derived := newDynamicArray[U](d.Capacity(), &config{
+		maxCapacity: d.maxCapacity,
+		growth:      d.growth,
+	})
+	derived.minCapacity = d.minCapacity
+	return derived
+}
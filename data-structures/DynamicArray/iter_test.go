@@ -0,0 +1,147 @@
+package dynamicarray
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	t.Run("iterate over an array in order", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+		_ = array.AddAll(10, 20, 30)
+
+		var indexes, elements []int
+		for index, element := range array.All() {
+			indexes = append(indexes, index)
+			elements = append(elements, element)
+		}
+
+		assertSlice(t, indexes, []int{0, 1, 2})
+		assertSlice(t, elements, []int{10, 20, 30})
+	})
+
+	t.Run("stop iteration early", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+		_ = array.AddAll(10, 20, 30)
+
+		var elements []int
+		for _, element := range array.All() {
+			if element == 20 {
+				break
+			}
+			elements = append(elements, element)
+		}
+
+		assertSlice(t, elements, []int{10})
+	})
+
+	t.Run("iterate over an empty array", func(t *testing.T) {
+		for range NewDynamicArray[int]().All() {
+			t.Errorf("expected no elements")
+		}
+	})
+}
+
+func TestBackward(t *testing.T) {
+	array := NewDynamicArray[int]()
+	_ = array.AddAll(10, 20, 30)
+
+	var indexes, elements []int
+	for index, element := range array.Backward() {
+		indexes = append(indexes, index)
+		elements = append(elements, element)
+		if index == 1 {
+			break
+		}
+	}
+
+	assertSlice(t, indexes, []int{2, 1})
+	assertSlice(t, elements, []int{30, 20})
+}
+
+func TestEach(t *testing.T) {
+	array := NewDynamicArray[int]()
+	_ = array.AddAll(1, 2, 3)
+
+	sum := 0
+	array.Each(func(index int, element int) {
+		sum += index * element
+	})
+
+	assertEqual(t, sum, 8)
+}
+
+func TestMap(t *testing.T) {
+	array := NewDynamicArray[int](WithMaxCapacity(16))
+	_ = array.AddAll(1, 2, 3)
+
+	mapped := Map(array, strconv.Itoa)
+
+	assertElements(t, mapped, "1", "2", "3")
+	assertEqual(t, mapped.maxCapacity, int32(16))
+	assertElements(t, array, 1, 2, 3)
+}
+
+func TestFilter(t *testing.T) {
+	array := NewDynamicArray[int]()
+	for i := 0; i < 100; i++ {
+		_ = array.Add(i)
+	}
+
+	filtered := Filter(array, func(element int) bool { return element%25 == 0 })
+
+	assertElements(t, filtered, 0, 25, 50, 75)
+	assertCorrectCapacity(t, filtered, defaultCapacity<<2)
+	assertCorrectLength(t, array, 100)
+}
+
+func TestReduce(t *testing.T) {
+	array := NewDynamicArray[int]()
+	_ = array.AddAll(1, 2, 3, 4)
+
+	sum := Reduce(array, 0, func(accumulator, element int) int { return accumulator + element })
+	joined := Reduce(array, "", func(accumulator string, element int) string {
+		return accumulator + strconv.Itoa(element)
+	})
+
+	assertEqual(t, sum, 10)
+	assertEqual(t, joined, "1234")
+	assertEqual(t, Reduce(NewDynamicArray[int](), 7, func(a, b int) int { return a + b }), 7)
+}
+
+func TestAnyEvery(t *testing.T) {
+	isEven := func(element int) bool { return element%2 == 0 }
+	tests := []struct {
+		name          string
+		values        []int
+		expectedAny   bool
+		expectedEvery bool
+	}{
+		{name: "empty array", values: []int{}, expectedAny: false, expectedEvery: true},
+		{name: "all match", values: []int{2, 4, 6}, expectedAny: true, expectedEvery: true},
+		{name: "some match", values: []int{1, 2, 3}, expectedAny: true, expectedEvery: false},
+		{name: "none match", values: []int{1, 3, 5}, expectedAny: false, expectedEvery: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			array := NewDynamicArray[int]()
+			_ = array.AddAll(test.values...)
+
+			assertEqual(t, Any(array, isEven), test.expectedAny)
+			assertEqual(t, Every(array, isEven), test.expectedEvery)
+		})
+	}
+}
+
+func assertSlice[T comparable](t *testing.T, actual, expected []T) {
+	t.Helper()
+	if len(actual) != len(expected) {
+		t.Fatalf("expected '%v', but got '%v'", expected, actual)
+	}
+	for index := range expected {
+		if actual[index] != expected[index] {
+			t.Fatalf("expected '%v', but got '%v'", expected, actual)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package dynamicarray
+
+import "math"
+
+// GrowthPolicy computes the next capacity of an array from the current one.
+// Whatever a policy returns, an array grows by at least one element and never
+// beyond its maximum capacity.
+type GrowthPolicy func(capacity int32) int32
+
+// DoublingGrowth doubles a capacity of an array. It's the default policy.
+func DoublingGrowth(capacity int32) int32 {
+	return saturate(int64(capacity) << 1)
+}
+
+// OneAndHalfGrowth increases a capacity of an array by half. It trades more
+// frequent resizes for less unused memory.
+func OneAndHalfGrowth(capacity int32) int32 {
+	return saturate(int64(capacity) + int64(capacity>>1))
+}
+
+// IncrementGrowth increases a capacity of an array by a fixed step.
+func IncrementGrowth(step int32) GrowthPolicy {
+	return func(capacity int32) int32 {
+		return saturate(int64(capacity) + int64(step))
+	}
+}
+
+func saturate(capacity int64) int32 {
+	if capacity > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(capacity)
+}
+
+// Option configures a dynamic array on creation.
+type Option func(*config)
+
+type config struct {
+	maxCapacity int32
+	growth      GrowthPolicy
+}
+
+func newConfig(options []Option) *config {
+	cfg := &config{growth: DoublingGrowth}
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
+
+// WithMaxCapacity limits how far an array can grow. An array holds up to the
+// limit of elements, and adding more returns ErrorExceededCapacity. A
+// non-positive value keeps an array unbounded.
+func WithMaxCapacity(capacity int32) Option {
+	return func(c *config) {
+		c.maxCapacity = capacity
+	}
+}
+
+// WithGrowthPolicy sets a policy used to grow an array when it's full.
+func WithGrowthPolicy(policy GrowthPolicy) Option {
+	return func(c *config) {
+		if policy != nil {
+			c.growth = policy
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package dynamicarray
+
+// FromSlice creates a new dynamic array holding copies of given elements. An
+// array is allocated once, and it returns ErrorExceededCapacity if the elements
+// don't fit into a maximum capacity.
+func FromSlice[T any](elements []T, options ...Option) (*DynamicArray[T], error) {
+	array := NewDynamicArray[T](options...)
+	if err := array.AddAll(elements...); err != nil {
+		return nil, err
+	}
+	return array, nil
+}
+
+// ToSlice returns a copy of elements of an array.
+func (d *DynamicArray[T]) ToSlice() []T {
+	elements := make([]T, d.length)
+	copy(elements, d.container[:d.length])
+	return elements
+}
+
+// Clone returns a copy of an array that doesn't share storage with the original
+// one, so changes to either array aren't visible in the other.
+func (d *DynamicArray[T]) Clone() *DynamicArray[T] {
+	clone := *d
+	clone.container = make([]T, d.capacity)
+	copy(clone.container, d.container[:d.length])
+	clone.shared = false
+	return &clone
+}
+
+// SubArray returns a view of elements in a half-open range [from, to). A view
+// shares storage with the original array the same way Go slices do: changes made
+// through Set are visible in both arrays until either of them is reallocated.
+// Storage is copied on write before any change that moves or clears elements,
+// e.g. InsertAt, RemoveAt, Truncate, Reverse or Sort, and a view is reallocated as
+// soon as it grows, so such changes are never visible in the other array.
+func (d *DynamicArray[T]) SubArray(from, to int32) (*DynamicArray[T], error) {
+	if from < 0 || from > to || to > d.length {
+		return nil, ErrorIndexOutOfRange
+	}
+	view := *d
+	view.container = d.container[from:to:to]
+	view.length = to - from
+	view.capacity = to - from
+	view.minCapacity = to - from
+	view.shared, d.shared = true, true
+	return &view, nil
+}
+
+// Equal reports whether arrays have the same elements in the same order.
+// Elements are compared the same way Find does.
+func (d *DynamicArray[T]) Equal(other *DynamicArray[T]) bool {
+	return d.EqualFunc(other, defaultEqual[T]())
+}
+
+// EqualFunc reports whether arrays have the same elements in the same order
+// using a given equality function.
+func (d *DynamicArray[T]) EqualFunc(other *DynamicArray[T], equal func(a, b T) bool) bool {
+	if d.length != other.length {
+		return false
+	}
+	for index := int32(0); index < d.length; index++ {
+		if !equal(d.container[index], other.container[index]) {
+			return false
+		}
+	}
+	return true
+}
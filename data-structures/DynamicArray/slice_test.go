@@ -0,0 +1,200 @@
+package dynamicarray
+
+import "testing"
+
+func TestFromSlice(t *testing.T) {
+	t.Run("create an array from a slice", func(t *testing.T) {
+		values := []int{1, 2, 3, 4, 5}
+
+		array, err := FromSlice(values)
+		values[0] = 10
+
+		assertError(t, err, nil)
+		assertElements(t, array, 1, 2, 3, 4, 5)
+	})
+
+	t.Run("create an array from an empty slice", func(t *testing.T) {
+		array, err := FromSlice([]int{})
+
+		assertError(t, err, nil)
+		assertElements(t, array)
+		assertCorrectCapacity(t, array, defaultCapacity)
+	})
+
+	t.Run("create an array exceeding a maximum capacity", func(t *testing.T) {
+		array, err := FromSlice([]int{1, 2, 3, 4, 5}, WithMaxCapacity(4))
+
+		assertError(t, err, ErrorExceededCapacity)
+		if array != nil {
+			t.Errorf("expected no array, but got %v", array)
+		}
+	})
+}
+
+func TestToSlice(t *testing.T) {
+	array, _ := FromSlice([]int{1, 2, 3})
+
+	values := array.ToSlice()
+	values[0] = 10
+
+	assertSlice(t, values, []int{10, 2, 3})
+	assertElements(t, array, 1, 2, 3)
+	assertSlice(t, NewDynamicArray[int]().ToSlice(), []int{})
+}
+
+func TestClone(t *testing.T) {
+	array, _ := FromSlice([]int{1, 2, 3}, WithMaxCapacity(8))
+
+	clone := array.Clone()
+	_ = clone.Set(0, 10)
+	_ = clone.Add(4)
+	_ = array.RemoveAt(2)
+
+	assertElements(t, array, 1, 2)
+	assertElements(t, clone, 10, 2, 3, 4)
+	assertEqual(t, clone.maxCapacity, int32(8))
+}
+
+func TestSubArray(t *testing.T) {
+	t.Run("a view shares storage with an array", func(t *testing.T) {
+		array, _ := FromSlice([]int{1, 2, 3, 4, 5})
+
+		view, err := array.SubArray(1, 4)
+		_ = view.Set(0, 20)
+		_ = array.Set(3, 40)
+
+		assertError(t, err, nil)
+		assertElements(t, view, 20, 3, 40)
+		assertElements(t, array, 1, 20, 3, 40, 5)
+	})
+
+	t.Run("a growing view doesn't overwrite an array", func(t *testing.T) {
+		array, _ := FromSlice([]int{1, 2, 3, 4, 5})
+
+		view, _ := array.SubArray(0, 2)
+		_ = view.Add(30)
+		_ = view.Set(0, 10)
+
+		assertElements(t, view, 10, 2, 30)
+		assertElements(t, array, 1, 2, 3, 4, 5)
+	})
+
+	t.Run("structural changes of a view don't write through", func(t *testing.T) {
+		changes := map[string]func(view *DynamicArray[int]){
+			"remove at":    func(view *DynamicArray[int]) { _ = view.RemoveAt(0) },
+			"remove range": func(view *DynamicArray[int]) { _ = view.RemoveRange(0, 2) },
+			"truncate":     func(view *DynamicArray[int]) { _ = view.Truncate(1) },
+			"clear":        func(view *DynamicArray[int]) { view.Clear() },
+			"reverse":      func(view *DynamicArray[int]) { view.Reverse() },
+			"sort": func(view *DynamicArray[int]) {
+				view.Sort(func(a, b int) bool { return a > b })
+			},
+		}
+
+		for name, change := range changes {
+			t.Run(name, func(t *testing.T) {
+				array, _ := FromSlice([]int{1, 2, 3, 4, 5})
+				view, _ := array.SubArray(1, 4)
+
+				change(view)
+				_ = view.Set(0, 0)
+
+				assertElements(t, array, 1, 2, 3, 4, 5)
+			})
+		}
+	})
+
+	t.Run("structural changes of an array don't write through", func(t *testing.T) {
+		array, _ := FromSlice([]int{1, 2, 3, 4, 5})
+		view, _ := array.SubArray(1, 4)
+
+		_ = array.RemoveAt(1)
+		_ = array.InsertAt(0, 0)
+
+		assertElements(t, array, 0, 1, 3, 4, 5)
+		assertElements(t, view, 2, 3, 4)
+	})
+
+	t.Run("an empty view", func(t *testing.T) {
+		array, _ := FromSlice([]int{1, 2, 3})
+
+		view, err := array.SubArray(3, 3)
+		_ = view.Add(4)
+
+		assertError(t, err, nil)
+		assertElements(t, view, 4)
+		assertElements(t, array, 1, 2, 3)
+	})
+
+	t.Run("a view out of range", func(t *testing.T) {
+		array, _ := FromSlice([]int{1, 2, 3})
+
+		for _, bounds := range [][2]int32{{-1, 2}, {2, 1}, {0, 4}} {
+			_, err := array.SubArray(bounds[0], bounds[1])
+
+			assertError(t, err, ErrorIndexOutOfRange)
+		}
+	})
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected bool
+	}{
+		{name: "empty arrays", a: []int{}, b: []int{}, expected: true},
+		{name: "same elements", a: []int{1, 2, 3}, b: []int{1, 2, 3}, expected: true},
+		{name: "different order", a: []int{1, 2, 3}, b: []int{3, 2, 1}, expected: false},
+		{name: "different length", a: []int{1, 2}, b: []int{1, 2, 3}, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, _ := FromSlice(test.a)
+			b, _ := FromSlice(test.b, WithGrowthPolicy(IncrementGrowth(1)))
+
+			assertEqual(t, a.Equal(b), test.expected)
+			assertEqual(t, b.Equal(a), test.expected)
+		})
+	}
+
+	t.Run("snapshot before mutation", func(t *testing.T) {
+		array, _ := FromSlice([]int{3, 1, 2})
+		snapshot := array.Clone()
+
+		array.Sort(intLess)
+
+		assertEqual(t, array.Equal(snapshot), false)
+		snapshot.Sort(intLess)
+		assertEqual(t, array.Equal(snapshot), true)
+	})
+
+	t.Run("equal arrays of uncomparable elements", func(t *testing.T) {
+		a, _ := FromSlice([][]int{{1}, {2, 3}})
+		b, _ := FromSlice([][]int{{1}, {2, 3}})
+		c, _ := FromSlice([][]int{{1}, {2}})
+
+		assertEqual(t, a.Equal(b), true)
+		assertEqual(t, a.Equal(c), false)
+	})
+
+	t.Run("equal with a custom equality", func(t *testing.T) {
+		a, _ := FromSlice([][]int{{1}, {2, 3}})
+		b, _ := FromSlice([][]int{{1}, {2, 3}})
+
+		equal := a.EqualFunc(b, func(x, y []int) bool {
+			if len(x) != len(y) {
+				return false
+			}
+			for index := range x {
+				if x[index] != y[index] {
+					return false
+				}
+			}
+			return true
+		})
+
+		assertEqual(t, equal, true)
+	})
+}
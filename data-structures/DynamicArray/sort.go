@@ -0,0 +1,74 @@
+package dynamicarray
+
+import "sort"
+
+var _ sort.Interface = sorter[int]{}
+
+// sorter adapts an array to sort.Interface with a given ordering.
+type sorter[T any] struct {
+	array *DynamicArray[T]
+	less  func(a, b T) bool
+}
+
+// Sorter returns a sort.Interface over elements of an array ordered by a less
+// function, so an array plugs into the standard library, e.g.
+// sort.Sort(array.Sorter(less)). Sorting it sorts the array in place.
+func (d *DynamicArray[T]) Sorter(less func(a, b T) bool) sort.Interface {
+	return sorter[T]{array: d, less: less}
+}
+
+func (s sorter[T]) Len() int {
+	return int(s.array.length)
+}
+
+func (s sorter[T]) Less(i, j int) bool {
+	return s.less(s.array.container[i], s.array.container[j])
+}
+
+func (s sorter[T]) Swap(i, j int) {
+	s.array.unshare()
+	container := s.array.container
+	container[i], container[j] = container[j], container[i]
+}
+
+// Sort sorts an array in ascending order as determined by a less function.
+// The sort isn't guaranteed to be stable.
+func (d *DynamicArray[T]) Sort(less func(a, b T) bool) {
+	d.unshare()
+	elements := d.container[:d.length]
+	sort.Slice(elements, func(i, j int) bool {
+		return less(elements[i], elements[j])
+	})
+}
+
+// SortStable sorts an array in ascending order as determined by a less function
+// keeping the original order of equal elements.
+func (d *DynamicArray[T]) SortStable(less func(a, b T) bool) {
+	d.unshare()
+	elements := d.container[:d.length]
+	sort.SliceStable(elements, func(i, j int) bool {
+		return less(elements[i], elements[j])
+	})
+}
+
+// IsSorted reports whether an array is sorted in ascending order as determined
+// by a less function.
+func (d *DynamicArray[T]) IsSorted(less func(a, b T) bool) bool {
+	for index := int32(1); index < d.length; index++ {
+		if less(d.container[index], d.container[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// BinarySearch searches for an element in an array sorted in ascending order
+// as determined by cmp, which returns a negative number when a < b, zero when
+// a == b and a positive number when a > b. It returns an index where an element
+// is found or would be inserted to keep the order, and whether it is found.
+func (d *DynamicArray[T]) BinarySearch(element T, cmp func(a, b T) int) (int32, bool) {
+	index := sort.Search(int(d.length), func(i int) bool {
+		return cmp(d.container[i], element) >= 0
+	})
+	return int32(index), index < int(d.length) && cmp(d.container[index], element) == 0
+}
@@ -0,0 +1,155 @@
+package dynamicarray
+
+import (
+	"sort"
+	"testing"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func intLess(a, b int) bool {
+	return a < b
+}
+
+func intCompare(a, b int) int {
+	return a - b
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{name: "empty array", values: []int{}, expected: []int{}},
+		{name: "single element", values: []int{1}, expected: []int{1}},
+		{name: "sorted array", values: []int{1, 2, 3}, expected: []int{1, 2, 3}},
+		{name: "reversed array", values: []int{5, 4, 3, 2, 1}, expected: []int{1, 2, 3, 4, 5}},
+		{name: "duplicates", values: []int{3, 1, 3, 2, 1}, expected: []int{1, 1, 2, 3, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			array := NewDynamicArray[int]()
+			_ = array.AddAll(test.values...)
+
+			array.Sort(intLess)
+
+			assertElements(t, array, test.expected...)
+			assertEqual(t, array.IsSorted(intLess), true)
+		})
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	array := NewDynamicArray[person]()
+	_ = array.AddAll(
+		person{name: "alice", age: 30},
+		person{name: "bob", age: 25},
+		person{name: "carol", age: 30},
+		person{name: "dave", age: 25},
+	)
+
+	array.SortStable(func(a, b person) bool { return a.age < b.age })
+
+	assertElements(t, array,
+		person{name: "bob", age: 25},
+		person{name: "dave", age: 25},
+		person{name: "alice", age: 30},
+		person{name: "carol", age: 30},
+	)
+}
+
+func TestIsSorted(t *testing.T) {
+	array := NewDynamicArray[int]()
+	assertEqual(t, array.IsSorted(intLess), true)
+
+	_ = array.AddAll(1, 2, 2, 3)
+	assertEqual(t, array.IsSorted(intLess), true)
+
+	_ = array.Add(0)
+	assertEqual(t, array.IsSorted(intLess), false)
+}
+
+func TestBinarySearch(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         int
+		expectedIndex int32
+		expectedFound bool
+	}{
+		{name: "first element", value: 1, expectedIndex: 0, expectedFound: true},
+		{name: "middle element", value: 5, expectedIndex: 2, expectedFound: true},
+		{name: "last element", value: 9, expectedIndex: 4, expectedFound: true},
+		{name: "before the first element", value: 0, expectedIndex: 0, expectedFound: false},
+		{name: "between elements", value: 4, expectedIndex: 2, expectedFound: false},
+		{name: "after the last element", value: 10, expectedIndex: 5, expectedFound: false},
+	}
+
+	array := NewDynamicArray[int]()
+	_ = array.AddAll(1, 3, 5, 7, 9)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			index, found := array.BinarySearch(test.value, intCompare)
+
+			assertEqual(t, index, test.expectedIndex)
+			assertEqual(t, found, test.expectedFound)
+		})
+	}
+
+	t.Run("search an empty array", func(t *testing.T) {
+		index, found := NewDynamicArray[int]().BinarySearch(1, intCompare)
+
+		assertEqual(t, index, int32(0))
+		assertEqual(t, found, false)
+	})
+
+	t.Run("insertion point keeps an array sorted", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+		for _, value := range []int{5, 2, 8, 1, 9, 3} {
+			index, _ := array.BinarySearch(value, intCompare)
+			_ = array.InsertAt(index, value)
+		}
+
+		assertElements(t, array, 1, 2, 3, 5, 8, 9)
+	})
+}
+
+func TestSorter(t *testing.T) {
+	t.Run("sort with the standard library", func(t *testing.T) {
+		array := NewDynamicArray[int]()
+		_ = array.AddAll(3, 1, 2)
+
+		sort.Sort(sort.Reverse(array.Sorter(intLess)))
+
+		assertElements(t, array, 3, 2, 1)
+		assertEqual(t, sort.IsSorted(array.Sorter(intLess)), false)
+	})
+
+	t.Run("arrays from any constructor can be sorted", func(t *testing.T) {
+		withCapacity, _ := NewDynamicArrayWithCapacity[int](2)
+		_ = withCapacity.AddAll(2, 1)
+		fromSlice, _ := FromSlice([]int{3, 1, 2})
+		mapped := Map(fromSlice, func(value int) int { return -value })
+
+		for _, array := range []*DynamicArray[int]{withCapacity, fromSlice, mapped} {
+			sort.Stable(array.Sorter(intLess))
+
+			assertEqual(t, array.IsSorted(intLess), true)
+		}
+	})
+
+	t.Run("sorting a view doesn't reorder an array", func(t *testing.T) {
+		array, _ := FromSlice([]int{1, 5, 4, 3, 2})
+		view, _ := array.SubArray(1, 4)
+
+		sort.Sort(view.Sorter(intLess))
+
+		assertElements(t, view, 3, 4, 5)
+		assertElements(t, array, 1, 5, 4, 3, 2)
+	})
+}
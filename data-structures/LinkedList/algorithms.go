@@ -0,0 +1,157 @@
+package LinkedList
+
+// Reverse reverses a list in place by relinking its nodes.
+func (l *LinkedList[T]) Reverse() {
+	var prev *Node[T]
+	current := l.head
+	for current != nil {
+		current.Next, prev, current = prev, current, current.Next
+	}
+	l.head, l.tail = l.tail, l.head
+}
+
+// Sort sorts a list in ascending order as determined by a less function. It's a
+// stable merge sort, which relinks nodes in O(n log n) time without allocating.
+func (l *LinkedList[T]) Sort(less func(a, b T) bool) {
+	l.head = mergeSort(l.head, less)
+	l.tail = lastNode(l.head)
+}
+
+// HasCycle reports whether following Next pointers from a given node never
+// reaches the end. Lists built with Append and Prepend never have cycles, so it
+// is meant for chains of nodes linked by hand.
+func HasCycle[T any](head *Node[T]) bool {
+	_, ok := CycleStart(head)
+	return ok
+}
+
+// CycleStart returns the node where a cycle of a chain starting at a given node
+// begins using Floyd's algorithm. If a chain has no cycle it returns false.
+func CycleStart[T any](head *Node[T]) (*Node[T], bool) {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow, fast = slow.Next, fast.Next.Next
+		if slow == fast {
+			// The distance from the head to the start of a cycle equals the
+			// distance from the meeting point to it going around the cycle.
+			slow = head
+			for slow != fast {
+				slow, fast = slow.Next, fast.Next
+			}
+			return slow, true
+		}
+	}
+	return nil, false
+}
+
+// NthFromEnd returns the k-th element counting from the end of a list, where
+// k = 1 is the last element. It walks a list once using two pointers.
+func (l *LinkedList[T]) NthFromEnd(k int) (T, error) {
+	if k < 1 || k > l.size {
+		var zero T
+		return zero, ErrorIndexOutOfRange
+	}
+	lead, trail := l.head, l.head
+	for ; k > 0; k-- {
+		lead = lead.Next
+	}
+	for lead != nil {
+		lead, trail = lead.Next, trail.Next
+	}
+	return trail.Value, nil
+}
+
+// Middle returns the middle element of a list. A list of even size has two of
+// them, and the second one is returned. If a list is empty returns an error.
+func (l *LinkedList[T]) Middle() (T, error) {
+	if l.head == nil {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	slow, fast := l.head, l.head
+	for fast != nil && fast.Next != nil {
+		slow, fast = slow.Next, fast.Next.Next
+	}
+	return slow.Value, nil
+}
+
+// RemoveDuplicates keeps only the first occurrence of every value in a list.
+// Values are compared with an optional equality or the list's default one, so
+// it takes quadratic time.
+func (l *LinkedList[T]) RemoveDuplicates(equal ...func(a, b T) bool) {
+	isEqual := l.equalFunc(equal)
+	for current := l.head; current != nil; current = current.Next {
+		for prev := current; prev.Next != nil; {
+			if isEqual(prev.Next.Value, current.Value) {
+				l.removeAfter(prev)
+			} else {
+				prev = prev.Next
+			}
+		}
+	}
+}
+
+// MergeSorted merges two lists sorted by a less function into a new sorted list.
+// Nodes are moved rather than copied, so both lists are left empty. Equal
+// elements of a come before equal elements of b. Merging a list with itself
+// moves its nodes to a new list as they are.
+func MergeSorted[T any](a, b *LinkedList[T], less func(a, b T) bool) *LinkedList[T] {
+	if a == b {
+		merged := *a
+		a.Clear()
+		return &merged
+	}
+	merged := &LinkedList[T]{
+		head:  merge(a.head, b.head, less),
+		size:  a.size + b.size,
+		equal: a.equal,
+	}
+	merged.tail = lastNode(merged.head)
+	a.Clear()
+	b.Clear()
+	return merged
+}
+
+func mergeSort[T any](head *Node[T], less func(a, b T) bool) *Node[T] {
+	if head == nil || head.Next == nil {
+		return head
+	}
+	// Split a chain after its middle node, the first half is never shorter.
+	slow, fast := head, head.Next
+	for fast != nil && fast.Next != nil {
+		slow, fast = slow.Next, fast.Next.Next
+	}
+	second := slow.Next
+	slow.Next = nil
+	return merge(mergeSort(head, less), mergeSort(second, less), less)
+}
+
+func merge[T any](a, b *Node[T], less func(a, b T) bool) *Node[T] {
+	var sentinel Node[T]
+	tail := &sentinel
+	for a != nil && b != nil {
+		// Take from b only if it's strictly less to keep the merge stable.
+		if less(b.Value, a.Value) {
+			tail.Next, b = b, b.Next
+		} else {
+			tail.Next, a = a, a.Next
+		}
+		tail = tail.Next
+	}
+	if a != nil {
+		tail.Next = a
+	} else {
+		tail.Next = b
+	}
+	return sentinel.Next
+}
+
+func lastNode[T any](head *Node[T]) *Node[T] {
+	if head == nil {
+		return nil
+	}
+	for head.Next != nil {
+		head = head.Next
+	}
+	return head
+}
@@ -0,0 +1,256 @@
+package LinkedList
+
+import "testing"
+
+func intLess(a, b int) bool {
+	return a < b
+}
+
+func TestLinkedList_Reverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{name: "empty list", values: []int{}, expected: []int{}},
+		{name: "single node", values: []int{1}, expected: []int{1}},
+		{name: "two nodes", values: []int{1, 2}, expected: []int{2, 1}},
+		{name: "many nodes", values: []int{1, 2, 3, 4, 5}, expected: []int{5, 4, 3, 2, 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildList(test.values...)
+
+			list.Reverse()
+
+			assertList(t, list, test.expected...)
+		})
+	}
+}
+
+func TestLinkedList_Sort(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{name: "empty list", values: []int{}, expected: []int{}},
+		{name: "single node", values: []int{1}, expected: []int{1}},
+		{name: "sorted list", values: []int{1, 2, 3}, expected: []int{1, 2, 3}},
+		{name: "reversed list", values: []int{5, 4, 3, 2, 1}, expected: []int{1, 2, 3, 4, 5}},
+		{name: "duplicates", values: []int{3, 1, 2, 3, 1, 2}, expected: []int{1, 1, 2, 2, 3, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildList(test.values...)
+
+			list.Sort(intLess)
+
+			assertList(t, list, test.expected...)
+		})
+	}
+
+	t.Run("sort is stable", func(t *testing.T) {
+		type pair struct{ key, order int }
+		list := buildList(pair{2, 0}, pair{1, 1}, pair{2, 2}, pair{1, 3}, pair{0, 4})
+
+		list.Sort(func(a, b pair) bool { return a.key < b.key })
+
+		assertList(t, list, pair{0, 4}, pair{1, 1}, pair{1, 3}, pair{2, 0}, pair{2, 2})
+	})
+
+	t.Run("sort doesn't allocate", func(t *testing.T) {
+		list := buildList(9, 8, 7, 6, 5, 4, 3, 2, 1, 0)
+		descending := func(a, b int) bool { return a > b }
+
+		allocations := testing.AllocsPerRun(10, func() {
+			list.Sort(intLess)
+			list.Sort(descending)
+		})
+
+		assertEqual(t, allocations, float64(0))
+	})
+}
+
+func TestCycleDetection(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     []int
+		cycleStart int
+	}{
+		{name: "empty chain", values: []int{}, cycleStart: -1},
+		{name: "single node without a cycle", values: []int{1}, cycleStart: -1},
+		{name: "many nodes without a cycle", values: []int{1, 2, 3, 4}, cycleStart: -1},
+		{name: "single node pointing to itself", values: []int{1}, cycleStart: 0},
+		{name: "tail pointing to head", values: []int{1, 2, 3, 4}, cycleStart: 0},
+		{name: "tail pointing to middle", values: []int{1, 2, 3, 4, 5}, cycleStart: 2},
+		{name: "tail pointing to itself", values: []int{1, 2, 3, 4}, cycleStart: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nodes := make([]*Node[int], len(test.values))
+			for index := len(test.values) - 1; index >= 0; index-- {
+				nodes[index] = &Node[int]{Value: test.values[index]}
+				if index+1 < len(nodes) {
+					nodes[index].Next = nodes[index+1]
+				}
+			}
+			var head, expected *Node[int]
+			if len(nodes) > 0 {
+				head = nodes[0]
+			}
+			if test.cycleStart >= 0 {
+				expected = nodes[test.cycleStart]
+				nodes[len(nodes)-1].Next = expected
+			}
+
+			start, ok := CycleStart(head)
+
+			assertEqual(t, HasCycle(head), test.cycleStart >= 0)
+			assertEqual(t, ok, test.cycleStart >= 0)
+			assertEqual(t, start, expected)
+		})
+	}
+
+	t.Run("chain of a list", func(t *testing.T) {
+		list := buildList(1, 2, 3)
+
+		assertEqual(t, HasCycle(list.Head()), false)
+
+		list.Head().Next.Next.Next = list.Head()
+
+		assertEqual(t, HasCycle(list.Head()), true)
+	})
+}
+
+func TestLinkedList_NthFromEnd(t *testing.T) {
+	tests := []struct {
+		name     string
+		k        int
+		expected int
+		err      error
+	}{
+		{name: "last element", k: 1, expected: 5},
+		{name: "second from end", k: 2, expected: 4},
+		{name: "first element", k: 5, expected: 1},
+		{name: "beyond the head", k: 6, err: ErrorIndexOutOfRange},
+		{name: "zero", k: 0, err: ErrorIndexOutOfRange},
+	}
+
+	list := buildList(1, 2, 3, 4, 5)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := list.NthFromEnd(test.k)
+
+			assertError(t, err, test.err)
+			assertEqual(t, actual, test.expected)
+		})
+	}
+}
+
+func TestLinkedList_Middle(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+		err      error
+	}{
+		{name: "empty list", values: []int{}, err: ErrorEmptyList},
+		{name: "single node", values: []int{1}, expected: 1},
+		{name: "two nodes", values: []int{1, 2}, expected: 2},
+		{name: "odd size", values: []int{1, 2, 3, 4, 5}, expected: 3},
+		{name: "even size", values: []int{1, 2, 3, 4, 5, 6}, expected: 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := buildList(test.values...).Middle()
+
+			assertError(t, err, test.err)
+			assertEqual(t, actual, test.expected)
+		})
+	}
+}
+
+func TestLinkedList_RemoveDuplicates(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{name: "empty list", values: []int{}, expected: []int{}},
+		{name: "no duplicates", values: []int{1, 2, 3}, expected: []int{1, 2, 3}},
+		{name: "all duplicates", values: []int{1, 1, 1}, expected: []int{1}},
+		{name: "duplicates at the tail", values: []int{1, 2, 3, 2, 3}, expected: []int{1, 2, 3}},
+		{name: "unsorted duplicates", values: []int{3, 1, 3, 2, 1, 4}, expected: []int{3, 1, 2, 4}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildList(test.values...)
+
+			list.RemoveDuplicates()
+
+			assertList(t, list, test.expected...)
+		})
+	}
+
+	t.Run("remove duplicates with a custom equality", func(t *testing.T) {
+		list := buildList(1, 2, 3, 4, 5, 6)
+
+		list.RemoveDuplicates(func(a, b int) bool { return a%3 == b%3 })
+
+		assertList(t, list, 1, 2, 3)
+	})
+}
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected []int
+	}{
+		{name: "both empty", a: []int{}, b: []int{}, expected: []int{}},
+		{name: "first empty", a: []int{}, b: []int{1, 2}, expected: []int{1, 2}},
+		{name: "second empty", a: []int{1, 2}, b: []int{}, expected: []int{1, 2}},
+		{name: "interleaved", a: []int{1, 3, 5}, b: []int{2, 4, 6}, expected: []int{1, 2, 3, 4, 5, 6}},
+		{name: "one after another", a: []int{4, 5}, b: []int{1, 2, 3}, expected: []int{1, 2, 3, 4, 5}},
+		{name: "duplicates", a: []int{1, 2, 2}, b: []int{2, 3}, expected: []int{1, 2, 2, 2, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, b := buildList(test.a...), buildList(test.b...)
+
+			merged := MergeSorted(a, b, intLess)
+
+			assertList(t, merged, test.expected...)
+			assertList(t, a)
+			assertList(t, b)
+		})
+	}
+
+	t.Run("elements of the first list come first", func(t *testing.T) {
+		type pair struct{ key, list int }
+		byKey := func(a, b pair) bool { return a.key < b.key }
+		a, b := buildList(pair{1, 0}, pair{2, 0}), buildList(pair{1, 1}, pair{2, 1})
+
+		merged := MergeSorted(a, b, byKey)
+
+		assertList(t, merged, pair{1, 0}, pair{1, 1}, pair{2, 0}, pair{2, 1})
+	})
+
+	t.Run("merge a list with itself", func(t *testing.T) {
+		list := buildList(1, 2, 3)
+
+		merged := MergeSorted(list, list, intLess)
+
+		assertList(t, merged, 1, 2, 3)
+		assertList(t, list)
+		merged.Append(4)
+		assertList(t, merged, 1, 2, 3, 4)
+	})
+}
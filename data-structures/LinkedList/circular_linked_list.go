@@ -0,0 +1,196 @@
+package LinkedList
+
+import "iter"
+
+// CircularLinkedList is a singly linked list where the last node points back to
+// the first one. Keeping only a pointer to the tail is enough to reach both ends
+// in constant time, and rotating a list doesn't relink any nodes.
+type CircularLinkedList[T any] struct {
+	tail  *Node[T]
+	size  int
+	equal func(a, b T) bool
+}
+
+// NewCircularLinkedList builds an empty circular linked list and returns its
+// pointer. Searching compares values the same way LinkedList does.
+func NewCircularLinkedList[T any]() *CircularLinkedList[T] {
+	return &CircularLinkedList[T]{
+		tail:  nil,
+		size:  0,
+		equal: defaultEqual[T](),
+	}
+}
+
+// Size returns the number of nodes in a list.
+func (l *CircularLinkedList[T]) Size() int {
+	return l.size
+}
+
+// Append adds an element to the end of a list.
+func (l *CircularLinkedList[T]) Append(value T) {
+	l.Prepend(value)
+	l.tail = l.tail.Next
+}
+
+// Prepend adds an element to the front of a list.
+func (l *CircularLinkedList[T]) Prepend(value T) {
+	newNode := &Node[T]{Value: value}
+	if l.tail != nil {
+		newNode.Next, l.tail.Next = l.tail.Next, newNode
+	} else {
+		newNode.Next, l.tail = newNode, newNode
+	}
+	l.size++
+}
+
+// PeekFirst takes first element of a list. If a list is empty returns an error.
+func (l *CircularLinkedList[T]) PeekFirst() (T, error) {
+	if l.tail != nil {
+		return l.tail.Next.Value, nil
+	}
+	var zero T
+	return zero, ErrorEmptyList
+}
+
+// PeekLast takes last element of a list. If a list is empty returns an error.
+func (l *CircularLinkedList[T]) PeekLast() (T, error) {
+	if l.tail != nil {
+		return l.tail.Value, nil
+	}
+	var zero T
+	return zero, ErrorEmptyList
+}
+
+// Get returns an element by a given index.
+func (l *CircularLinkedList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, ErrorIndexOutOfRange
+	}
+	return l.advance(l.tail, index+1).Value, nil
+}
+
+// RemoveFirst removes the first element of a list and returns it. If a list is
+// empty returns an error.
+func (l *CircularLinkedList[T]) RemoveFirst() (T, error) {
+	if l.tail == nil {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	return l.removeAfter(l.tail), nil
+}
+
+// RemoveLast removes the last element of a list and returns it. It has to find
+// the node before the tail, so it takes linear time. If a list is empty returns
+// an error.
+func (l *CircularLinkedList[T]) RemoveLast() (T, error) {
+	if l.tail == nil {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	return l.removeAfter(l.advance(l.tail, l.size-1)), nil
+}
+
+// Count returns a number of times a given value is occurred in a list. Values
+// are compared with an optional equality or the list's default one.
+func (l *CircularLinkedList[T]) Count(value T, equal ...func(a, b T) bool) int {
+	isEqual, count := chooseEqual(equal, l.equal), 0
+	for element := range l.All() {
+		if isEqual(element, value) {
+			count++
+		}
+	}
+	return count
+}
+
+// Contains reports whether a given value is in a list. Values are compared
+// with an optional equality or the list's default one.
+func (l *CircularLinkedList[T]) Contains(value T, equal ...func(a, b T) bool) bool {
+	return l.IndexOf(value, equal...) >= 0
+}
+
+// IndexOf returns an index of the first occurrence of a given value in a list
+// or -1 if there is no such value. Values are compared with an optional
+// equality or the list's default one.
+func (l *CircularLinkedList[T]) IndexOf(value T, equal ...func(a, b T) bool) int {
+	isEqual, index := chooseEqual(equal, l.equal), 0
+	for element := range l.All() {
+		if isEqual(element, value) {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Clear removes all elements from a list.
+func (l *CircularLinkedList[T]) Clear() {
+	l.tail, l.size = nil, 0
+}
+
+// All returns an iterator over elements of a list starting from the first one
+// and going around a list once.
+func (l *CircularLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		current := l.tail
+		for i := 0; i < l.size; i++ {
+			current = current.Next
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Rotate makes the element at index k the first one by moving the tail pointer,
+// so no nodes are relinked. A negative k rotates a list backward.
+func (l *CircularLinkedList[T]) Rotate(k int) {
+	if l.size == 0 {
+		return
+	}
+	k %= l.size
+	if k < 0 {
+		k += l.size
+	}
+	l.tail = l.advance(l.tail, k)
+}
+
+// Josephus eliminates every k-th element going around a list until it is empty,
+// counting from the first element, and returns elements in the order they have
+// been eliminated. The last one is the survivor.
+func (l *CircularLinkedList[T]) Josephus(k int) ([]T, error) {
+	if k < 1 {
+		return nil, ErrorInvalidStep
+	}
+	eliminated := make([]T, 0, l.size)
+	prev := l.tail
+	for l.size > 0 {
+		// The list shrinks, so only the remaining nodes are worth walking.
+		prev = l.advance(prev, (k-1)%l.size)
+		eliminated = append(eliminated, l.removeAfter(prev))
+	}
+	return eliminated, nil
+}
+
+func (l *CircularLinkedList[T]) advance(node *Node[T], steps int) *Node[T] {
+	for ; steps > 0; steps-- {
+		node = node.Next
+	}
+	return node
+}
+
+// removeAfter unlinks a node following prev and returns its value.
+func (l *CircularLinkedList[T]) removeAfter(prev *Node[T]) T {
+	removed := prev.Next
+	if removed == prev {
+		l.tail = nil
+	} else {
+		prev.Next = removed.Next
+		if removed == l.tail {
+			l.tail = prev
+		}
+	}
+	removed.Next = nil
+	l.size--
+	return removed.Value
+}
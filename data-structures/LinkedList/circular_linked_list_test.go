@@ -0,0 +1,109 @@
+package LinkedList
+
+import "testing"
+
+func buildCircularList(values ...int) *CircularLinkedList[int] {
+	list := NewCircularLinkedList[int]()
+	for _, value := range values {
+		list.Append(value)
+	}
+	return list
+}
+
+func assertCircularList(t *testing.T, list *CircularLinkedList[int], expected ...int) {
+	t.Helper()
+	assertListElements(t, list, expected...)
+	if list.tail != nil {
+		// Walking size nodes from the tail has to come back to it.
+		assertEqual(t, list.advance(list.tail, list.size), list.tail)
+	}
+}
+
+func TestCircularLinkedList_All(t *testing.T) {
+	list := buildCircularList(1, 2, 3)
+
+	var values []int
+	for value := range list.All() {
+		values = append(values, value)
+	}
+
+	assertEqual(t, len(values), 3)
+	assertEqual(t, values[0], 1)
+	assertEqual(t, values[2], 3)
+
+	for range NewCircularLinkedList[int]().All() {
+		t.Errorf("expected no elements")
+	}
+}
+
+func TestCircularLinkedList_Rotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		k        int
+		expected []int
+	}{
+		{name: "rotate an empty list", values: []int{}, k: 1, expected: []int{}},
+		{name: "rotate by zero", values: []int{1, 2, 3}, k: 0, expected: []int{1, 2, 3}},
+		{name: "rotate by one", values: []int{1, 2, 3}, k: 1, expected: []int{2, 3, 1}},
+		{name: "rotate by more than size", values: []int{1, 2, 3, 4}, k: 6, expected: []int{3, 4, 1, 2}},
+		{name: "rotate backward", values: []int{1, 2, 3, 4}, k: -1, expected: []int{4, 1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildCircularList(test.values...)
+
+			list.Rotate(test.k)
+
+			assertCircularList(t, list, test.expected...)
+		})
+	}
+}
+
+func TestCircularLinkedList_Josephus(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		k        int
+		expected []int
+		err      error
+	}{
+		{name: "empty list", values: []int{}, k: 3, expected: []int{}},
+		{name: "single element", values: []int{1}, k: 3, expected: []int{1}},
+		{name: "every element", values: []int{1, 2, 3}, k: 1, expected: []int{1, 2, 3}},
+		{name: "every second element", values: []int{1, 2, 3, 4, 5}, k: 2, expected: []int{2, 4, 1, 5, 3}},
+		{name: "classic", values: []int{1, 2, 3, 4, 5, 6, 7}, k: 3, expected: []int{3, 6, 2, 7, 5, 1, 4}},
+		{name: "step larger than size", values: []int{1, 2, 3}, k: 5, expected: []int{2, 3, 1}},
+		{name: "invalid step", values: []int{1, 2}, k: 0, err: ErrorInvalidStep},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildCircularList(test.values...)
+
+			eliminated, err := list.Josephus(test.k)
+
+			assertError(t, err, test.err)
+			if err != nil {
+				assertCircularList(t, list, test.values...)
+				return
+			}
+			assertEqual(t, len(eliminated), len(test.expected))
+			for index := range test.expected {
+				assertEqual(t, eliminated[index], test.expected[index])
+			}
+			assertCircularList(t, list)
+		})
+	}
+}
+
+func TestCircularLinkedList_Remove(t *testing.T) {
+	list := buildCircularList(1, 2, 3)
+
+	_, _ = list.RemoveLast()
+	assertCircularList(t, list, 1, 2)
+
+	_, _ = list.RemoveFirst()
+	assertCircularList(t, list, 2)
+}
@@ -0,0 +1,195 @@
+package LinkedList
+
+import "iter"
+
+// DoublyNode is a unit in a doubly linked list, that stores data and pointers to
+// the previous and next elements.
+type DoublyNode[T any] struct {
+	Value T
+	prev  *DoublyNode[T]
+	next  *DoublyNode[T]
+	list  *DoublyLinkedList[T]
+}
+
+// Next returns the next node of a list or nil.
+func (n *DoublyNode[T]) Next() *DoublyNode[T] {
+	return n.next
+}
+
+// Prev returns the previous node of a list or nil.
+func (n *DoublyNode[T]) Prev() *DoublyNode[T] {
+	return n.prev
+}
+
+// DoublyLinkedList is a struct that has pointers to the first and last nodes and
+// also size of a list. Every node knows its neighbours, so a list can be walked
+// in both directions, and any node can be removed in constant time.
+type DoublyLinkedList[T any] struct {
+	head *DoublyNode[T]
+	tail *DoublyNode[T]
+	size int
+}
+
+// NewDoublyLinkedList builds an empty doubly linked list and returns its pointer.
+func NewDoublyLinkedList[T any]() *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{
+		head: nil,
+		tail: nil,
+		size: 0,
+	}
+}
+
+// Size returns the number of nodes in a list.
+func (l *DoublyLinkedList[T]) Size() int {
+	return l.size
+}
+
+// First returns the first node of a list or nil if a list is empty.
+func (l *DoublyLinkedList[T]) First() *DoublyNode[T] {
+	return l.head
+}
+
+// Last returns the last node of a list or nil if a list is empty.
+func (l *DoublyLinkedList[T]) Last() *DoublyNode[T] {
+	return l.tail
+}
+
+// Append adds an element to the end of a list and returns its node.
+func (l *DoublyLinkedList[T]) Append(value T) *DoublyNode[T] {
+	return l.link(&DoublyNode[T]{Value: value}, l.tail, nil)
+}
+
+// Prepend adds an element to the front of a list and returns its node.
+func (l *DoublyLinkedList[T]) Prepend(value T) *DoublyNode[T] {
+	return l.link(&DoublyNode[T]{Value: value}, nil, l.head)
+}
+
+// InsertBefore adds an element right before a given node of a list and returns
+// a new node.
+func (l *DoublyLinkedList[T]) InsertBefore(node *DoublyNode[T], value T) (*DoublyNode[T], error) {
+	if err := l.checkNode(node); err != nil {
+		return nil, err
+	}
+	return l.link(&DoublyNode[T]{Value: value}, node.prev, node), nil
+}
+
+// InsertAfter adds an element right after a given node of a list and returns
+// a new node.
+func (l *DoublyLinkedList[T]) InsertAfter(node *DoublyNode[T], value T) (*DoublyNode[T], error) {
+	if err := l.checkNode(node); err != nil {
+		return nil, err
+	}
+	return l.link(&DoublyNode[T]{Value: value}, node, node.next), nil
+}
+
+// PeekFirst takes first element of a list. If a list is empty returns an error.
+func (l *DoublyLinkedList[T]) PeekFirst() (T, error) {
+	if l.head != nil {
+		return l.head.Value, nil
+	}
+	var zero T
+	return zero, ErrorEmptyList
+}
+
+// PeekLast takes last element of a list. If a list is empty returns an error.
+func (l *DoublyLinkedList[T]) PeekLast() (T, error) {
+	if l.tail != nil {
+		return l.tail.Value, nil
+	}
+	var zero T
+	return zero, ErrorEmptyList
+}
+
+// RemoveFirst removes the first element of a list and returns it. If a list is
+// empty returns an error.
+func (l *DoublyLinkedList[T]) RemoveFirst() (T, error) {
+	if l.head == nil {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	return l.unlink(l.head), nil
+}
+
+// RemoveLast removes the last element of a list and returns it. If a list is
+// empty returns an error.
+func (l *DoublyLinkedList[T]) RemoveLast() (T, error) {
+	if l.tail == nil {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	return l.unlink(l.tail), nil
+}
+
+// Remove removes a given node from a list in constant time and returns its value.
+func (l *DoublyLinkedList[T]) Remove(node *DoublyNode[T]) (T, error) {
+	if err := l.checkNode(node); err != nil {
+		var zero T
+		return zero, err
+	}
+	return l.unlink(node), nil
+}
+
+// All returns an iterator over elements of a list from the first to the last one.
+func (l *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over elements of a list from the last to the
+// first one.
+func (l *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.tail; current != nil; current = current.prev {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// link puts a node between prev and next, either of which can be nil at the
+// ends of a list.
+func (l *DoublyLinkedList[T]) link(node, prev, next *DoublyNode[T]) *DoublyNode[T] {
+	node.prev, node.next, node.list = prev, next, l
+	if prev != nil {
+		prev.next = node
+	} else {
+		l.head = node
+	}
+	if next != nil {
+		next.prev = node
+	} else {
+		l.tail = node
+	}
+	l.size++
+	return node
+}
+
+func (l *DoublyLinkedList[T]) unlink(node *DoublyNode[T]) T {
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		l.head = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		l.tail = node.prev
+	}
+	// Forget neighbours, so a removed node doesn't keep a list alive.
+	node.prev, node.next, node.list = nil, nil, nil
+	l.size--
+	return node.Value
+}
+
+func (l *DoublyLinkedList[T]) checkNode(node *DoublyNode[T]) error {
+	if node == nil || node.list != l {
+		return ErrorNodeNotInList
+	}
+	return nil
+}
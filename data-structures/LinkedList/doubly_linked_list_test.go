@@ -0,0 +1,225 @@
+package LinkedList
+
+import (
+	"iter"
+	"testing"
+)
+
+func assertSequence(t *testing.T, sequence iter.Seq[int], expected ...int) {
+	t.Helper()
+	var actual []int
+	for value := range sequence {
+		actual = append(actual, value)
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected elements %v, but got: %v", expected, actual)
+	}
+	for index := range expected {
+		if actual[index] != expected[index] {
+			t.Fatalf("expected elements %v, but got: %v", expected, actual)
+		}
+	}
+}
+
+func assertDoublyList(t *testing.T, list *DoublyLinkedList[int], expected ...int) {
+	t.Helper()
+	assertLength(t, list, len(expected))
+	assertSequence(t, list.All(), expected...)
+
+	reversed := make([]int, len(expected))
+	for index, value := range expected {
+		reversed[len(expected)-1-index] = value
+	}
+	assertSequence(t, list.Backward(), reversed...)
+}
+
+func TestNewDoublyLinkedList(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+
+	assertDoublyList(t, list)
+	assertEqual(t, list.First(), (*DoublyNode[int])(nil))
+	assertEqual(t, list.Last(), (*DoublyNode[int])(nil))
+}
+
+func TestDoublyLinkedList_AppendPrepend(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+
+	list.Append(2)
+	list.Prepend(1)
+	list.Append(3)
+	first, _ := list.PeekFirst()
+	last, _ := list.PeekLast()
+
+	assertDoublyList(t, list, 1, 2, 3)
+	assertEqual(t, first, 1)
+	assertEqual(t, last, 3)
+}
+
+func TestDoublyLinkedList_Peek(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+
+	_, err := list.PeekFirst()
+	assertError(t, err, ErrorEmptyList)
+
+	_, err = list.PeekLast()
+	assertError(t, err, ErrorEmptyList)
+}
+
+func TestDoublyLinkedList_RemoveFirstLast(t *testing.T) {
+	t.Run("remove from both ends", func(t *testing.T) {
+		list := NewDoublyLinkedList[int]()
+		list.Append(1)
+		list.Append(2)
+		list.Append(3)
+
+		first, err := list.RemoveFirst()
+		assertError(t, err, nil)
+		assertEqual(t, first, 1)
+
+		last, err := list.RemoveLast()
+		assertError(t, err, nil)
+		assertEqual(t, last, 3)
+
+		assertDoublyList(t, list, 2)
+	})
+
+	t.Run("remove the only element", func(t *testing.T) {
+		list := NewDoublyLinkedList[int]()
+		list.Append(1)
+
+		value, err := list.RemoveLast()
+
+		assertError(t, err, nil)
+		assertEqual(t, value, 1)
+		assertDoublyList(t, list)
+		assertEqual(t, list.First(), (*DoublyNode[int])(nil))
+		assertEqual(t, list.Last(), (*DoublyNode[int])(nil))
+	})
+
+	t.Run("remove from an empty list", func(t *testing.T) {
+		list := NewDoublyLinkedList[int]()
+
+		_, err := list.RemoveFirst()
+		assertError(t, err, ErrorEmptyList)
+
+		_, err = list.RemoveLast()
+		assertError(t, err, ErrorEmptyList)
+		assertLength(t, list, 0)
+	})
+}
+
+func TestDoublyLinkedList_Remove(t *testing.T) {
+	tests := []struct {
+		name     string
+		index    int
+		expected []int
+	}{
+		{name: "remove the first node", index: 0, expected: []int{2, 3}},
+		{name: "remove a middle node", index: 1, expected: []int{1, 3}},
+		{name: "remove the last node", index: 2, expected: []int{1, 2}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := NewDoublyLinkedList[int]()
+			nodes := []*DoublyNode[int]{list.Append(1), list.Append(2), list.Append(3)}
+
+			value, err := list.Remove(nodes[test.index])
+
+			assertError(t, err, nil)
+			assertEqual(t, value, test.index+1)
+			assertDoublyList(t, list, test.expected...)
+		})
+	}
+
+	t.Run("remove a node twice", func(t *testing.T) {
+		list := NewDoublyLinkedList[int]()
+		node := list.Append(1)
+
+		_, _ = list.Remove(node)
+		_, err := list.Remove(node)
+
+		assertError(t, err, ErrorNodeNotInList)
+		assertLength(t, list, 0)
+	})
+
+	t.Run("remove a node of another list", func(t *testing.T) {
+		list, other := NewDoublyLinkedList[int](), NewDoublyLinkedList[int]()
+		list.Append(1)
+		node := other.Append(1)
+
+		_, err := list.Remove(node)
+
+		assertError(t, err, ErrorNodeNotInList)
+		assertDoublyList(t, list, 1)
+		assertDoublyList(t, other, 1)
+	})
+
+	t.Run("remove nil", func(t *testing.T) {
+		_, err := NewDoublyLinkedList[int]().Remove(nil)
+
+		assertError(t, err, ErrorNodeNotInList)
+	})
+}
+
+func TestDoublyLinkedList_Insert(t *testing.T) {
+	t.Run("insert before and after nodes", func(t *testing.T) {
+		list := NewDoublyLinkedList[int]()
+		middle := list.Append(3)
+
+		_, _ = list.InsertBefore(middle, 2)
+		_, _ = list.InsertAfter(middle, 4)
+		_, _ = list.InsertBefore(list.First(), 1)
+		_, _ = list.InsertAfter(list.Last(), 5)
+
+		assertDoublyList(t, list, 1, 2, 3, 4, 5)
+	})
+
+	t.Run("inserted nodes can be removed", func(t *testing.T) {
+		list := NewDoublyLinkedList[int]()
+		first := list.Append(1)
+
+		node, err := list.InsertAfter(first, 2)
+		assertError(t, err, nil)
+
+		_, err = list.Remove(node)
+		assertError(t, err, nil)
+		assertDoublyList(t, list, 1)
+	})
+
+	t.Run("insert around a node of another list", func(t *testing.T) {
+		list, other := NewDoublyLinkedList[int](), NewDoublyLinkedList[int]()
+		node := other.Append(1)
+
+		_, err := list.InsertBefore(node, 2)
+		assertError(t, err, ErrorNodeNotInList)
+
+		_, err = list.InsertAfter(node, 2)
+		assertError(t, err, ErrorNodeNotInList)
+		assertDoublyList(t, list)
+	})
+}
+
+func TestDoublyLinkedList_Traversal(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		list.Append(i)
+	}
+
+	var forward []int
+	for node := list.First(); node != nil; node = node.Next() {
+		forward = append(forward, node.Value)
+	}
+	var backward []int
+	for value := range list.Backward() {
+		if value == 2 {
+			break
+		}
+		backward = append(backward, value)
+	}
+
+	assertEqual(t, len(forward), 5)
+	assertEqual(t, forward[4], 5)
+	assertEqual(t, len(backward), 3)
+	assertEqual(t, list.Last().Prev().Value, 4)
+}
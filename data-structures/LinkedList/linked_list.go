@@ -1,41 +1,122 @@
 package LinkedList
 
-import "errors"
+import (
+	"errors"
+	"reflect"
+)
 
 var (
-	ErrorEmptyList = errors.New("a list is empty")
+	ErrorEmptyList       = errors.New("a list is empty")
+	ErrorIndexOutOfRange = errors.New("an index should be >= 0 and < size of a list")
+	ErrorNodeNotInList   = errors.New("a node doesn't belong to a list")
+	ErrorKeyNotFound     = errors.New("there is no a needed key in a list")
+	ErrorInvalidStep     = errors.New("a step should be > 0")
 )
 
 // Node is a unit in a linked list, that stores data and a pointer to the next element.
-type Node struct {
-	Value interface{}
-	Next  *Node
+type Node[T any] struct {
+	Value T
+	Next  *Node[T]
 }
 
 // LinkedList is a struct that has pointers to the first and last nodes and also size of a list.
-type LinkedList struct {
-	head *Node
-	tail *Node
-	size int
+type LinkedList[T any] struct {
+	head  *Node[T]
+	tail  *Node[T]
+	size  int
+	equal func(a, b T) bool
+}
+
+// NewLinkedList builds an empty linked list and returns its pointer. Searching
+// compares values with == when T is comparable, and structurally otherwise.
+func NewLinkedList[T any]() *LinkedList[T] {
+	return &LinkedList[T]{
+		head:  nil,
+		tail:  nil,
+		size:  0,
+		equal: defaultEqual[T](),
+	}
 }
 
-// NewLinkedList builds an empty linked list and returns its pointer.
-func NewLinkedList() *LinkedList {
-	return &LinkedList{
+// NewComparableLinkedList builds an empty linked list of comparable values, which
+// are compared with == directly without reflection or boxing.
+func NewComparableLinkedList[T comparable]() *LinkedList[T] {
+	return &LinkedList[T]{
 		head: nil,
 		tail: nil,
 		size: 0,
+		equal: func(a, b T) bool {
+			return a == b
+		},
+	}
+}
+
+// defaultEqual picks an equality for values of T. Interfaces may hold values that
+// can't be compared with ==, so types containing them are compared structurally.
+func defaultEqual[T any]() func(a, b T) bool {
+	if safelyComparable(reflect.TypeFor[T]()) {
+		return func(a, b T) bool {
+			return any(a) == any(b)
+		}
+	}
+	return func(a, b T) bool {
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// safelyComparable reports whether == never panics on values of a type. Structs
+// and arrays are comparable even if they hold interfaces, while == panics if
+// such an interface holds e.g. a slice, so fields and elements are checked too.
+func safelyComparable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return safelyComparable(t.Elem())
+	case reflect.Struct:
+		for index := 0; index < t.NumField(); index++ {
+			if !safelyComparable(t.Field(index).Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return t.Comparable()
+}
+
+// equalFunc returns an equality given to a search method or the list's one.
+func (l *LinkedList[T]) equalFunc(equal []func(a, b T) bool) func(a, b T) bool {
+	return chooseEqual(equal, l.equal)
+}
+
+// chooseEqual returns an equality given to a search method or a list's one.
+// Zero value lists have no equality, so a default one is picked on every call
+// without storing it, which keeps search methods free of writes.
+func chooseEqual[T any](equal []func(a, b T) bool, fallback func(a, b T) bool) func(a, b T) bool {
+	if len(equal) > 0 && equal[0] != nil {
+		return equal[0]
 	}
+	if fallback == nil {
+		return defaultEqual[T]()
+	}
+	return fallback
+}
+
+// Head returns the first node of a list or nil if a list is empty, so a chain
+// of nodes can be walked or passed to functions like HasCycle. Relinking nodes
+// by hand leaves a list's size and tail stale.
+func (l *LinkedList[T]) Head() *Node[T] {
+	return l.head
 }
 
 // Size returns the number of nodes in a list.
-func (l *LinkedList) Size() int {
+func (l *LinkedList[T]) Size() int {
 	return l.size
 }
 
 // Append adds an element to the end of a list.
-func (l *LinkedList) Append(value interface{}) {
-	newNode := &Node{Value: value, Next: nil}
+func (l *LinkedList[T]) Append(value T) {
+	newNode := &Node[T]{Value: value, Next: nil}
 	if l.head != nil {
 		l.tail.Next, l.tail = newNode, newNode
 	} else {
@@ -45,8 +126,8 @@ func (l *LinkedList) Append(value interface{}) {
 }
 
 // Prepend adds an element to the front of a list.
-func (l *LinkedList) Prepend(value interface{}) {
-	newNode := &Node{Value: value, Next: l.head}
+func (l *LinkedList[T]) Prepend(value T) {
+	newNode := &Node[T]{Value: value, Next: l.head}
 	if l.head != nil {
 		l.head = newNode
 	} else {
@@ -56,29 +137,145 @@ func (l *LinkedList) Prepend(value interface{}) {
 }
 
 // PeekFirst takes first element of a list. If a list is empty returns an error.
-func (l *LinkedList) PeekFirst() (interface{}, error) {
+func (l *LinkedList[T]) PeekFirst() (T, error) {
 	if l.head != nil {
 		return l.head.Value, nil
 	}
-	return nil, ErrorEmptyList
+	var zero T
+	return zero, ErrorEmptyList
 }
 
 // PeekLast takes last element of a list. If a list is empty returns an error.
-func (l *LinkedList) PeekLast() (interface{}, error) {
+func (l *LinkedList[T]) PeekLast() (T, error) {
 	if l.tail != nil {
 		return l.tail.Value, nil
 	}
-	return nil, ErrorEmptyList
+	var zero T
+	return zero, ErrorEmptyList
 }
 
-// Count returns a number of times a given value is occurred in a list.
-func (l *LinkedList) Count(value interface{}) int {
+// Count returns a number of times a given value is occurred in a list. Values
+// are compared with an optional equality or the list's default one.
+func (l *LinkedList[T]) Count(value T, equal ...func(a, b T) bool) int {
+	isEqual := l.equalFunc(equal)
 	current, count := l.head, 0
 	for current != nil {
-		if current.Value == value {
+		if isEqual(current.Value, value) {
 			count++
 		}
 		current = current.Next
 	}
 	return count
 }
+
+// Contains reports whether a given value is in a list. Values are compared
+// with an optional equality or the list's default one.
+func (l *LinkedList[T]) Contains(value T, equal ...func(a, b T) bool) bool {
+	return l.IndexOf(value, equal...) >= 0
+}
+
+// IndexOf returns an index of the first occurrence of a given value in a list
+// or -1 if there is no such value. Values are compared with an optional
+// equality or the list's default one.
+func (l *LinkedList[T]) IndexOf(value T, equal ...func(a, b T) bool) int {
+	isEqual := l.equalFunc(equal)
+	for current, index := l.head, 0; current != nil; current, index = current.Next, index+1 {
+		if isEqual(current.Value, value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// Get returns an element by a given index.
+func (l *LinkedList[T]) Get(index int) (T, error) {
+	node, err := l.nodeAt(index)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return node.Value, nil
+}
+
+// RemoveFirst removes the first element of a list and returns it. If a list is
+// empty returns an error.
+func (l *LinkedList[T]) RemoveFirst() (T, error) {
+	if l.head == nil {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	return l.removeAfter(nil), nil
+}
+
+// RemoveLast removes the last element of a list and returns it. A singly linked
+// list has to find the node before the tail, so it takes linear time. If a list
+// is empty returns an error.
+func (l *LinkedList[T]) RemoveLast() (T, error) {
+	if l.tail == nil {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	return l.RemoveAt(l.size - 1)
+}
+
+// RemoveAt removes an element by a given index and returns it.
+func (l *LinkedList[T]) RemoveAt(index int) (T, error) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, ErrorIndexOutOfRange
+	}
+	if index == 0 {
+		return l.removeAfter(nil), nil
+	}
+	prev, _ := l.nodeAt(index - 1)
+	return l.removeAfter(prev), nil
+}
+
+// Remove removes the first occurrence of a given value from a list and reports
+// whether the value has been found. Values are compared with an optional
+// equality or the list's default one.
+func (l *LinkedList[T]) Remove(value T, equal ...func(a, b T) bool) bool {
+	isEqual := l.equalFunc(equal)
+	var prev *Node[T]
+	for current := l.head; current != nil; prev, current = current, current.Next {
+		if isEqual(current.Value, value) {
+			l.removeAfter(prev)
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes all elements from a list.
+func (l *LinkedList[T]) Clear() {
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+func (l *LinkedList[T]) nodeAt(index int) (*Node[T], error) {
+	if index < 0 || index >= l.size {
+		return nil, ErrorIndexOutOfRange
+	}
+	current := l.head
+	for ; index > 0; index-- {
+		current = current.Next
+	}
+	return current, nil
+}
+
+// removeAfter unlinks a node following prev, or the head if prev is nil, and
+// returns its value.
+func (l *LinkedList[T]) removeAfter(prev *Node[T]) T {
+	removed := l.head
+	if prev != nil {
+		removed = prev.Next
+		prev.Next = removed.Next
+	} else {
+		l.head = removed.Next
+	}
+	if removed == l.tail {
+		l.tail = prev
+	}
+	removed.Next = nil
+	l.size--
+	return removed.Value
+}
@@ -1,8 +1,12 @@
 package LinkedList
 
-import "testing"
+import (
+	"strings"
+	"sync"
+	"testing"
+)
 
-func assertLength(t *testing.T, list *LinkedList, expected int) {
+func assertLength(t *testing.T, list interface{ Size() int }, expected int) {
 	t.Helper()
 	actual := list.Size()
 	if actual != expected {
@@ -26,7 +30,7 @@ func assertError(t *testing.T, actual, expected error) {
 
 func TestNewLinkedList(t *testing.T) {
 	t.Run("Build a linked list", func(t *testing.T) {
-		list := NewLinkedList()
+		list := NewLinkedList[int]()
 
 		assertLength(t, list, 0)
 	})
@@ -34,7 +38,7 @@ func TestNewLinkedList(t *testing.T) {
 
 func TestLinkedList_Peek(t *testing.T) {
 	t.Run("PeekFirst throws an error on an empty list", func(t *testing.T) {
-		list := NewLinkedList()
+		list := NewLinkedList[int]()
 
 		_, err := list.PeekFirst()
 
@@ -43,7 +47,7 @@ func TestLinkedList_Peek(t *testing.T) {
 	})
 
 	t.Run("PeekLast throws an error on an empty list", func(t *testing.T) {
-		list := NewLinkedList()
+		list := NewLinkedList[int]()
 
 		_, err := list.PeekLast()
 
@@ -54,7 +58,7 @@ func TestLinkedList_Peek(t *testing.T) {
 
 func TestLinkedList_Append(t *testing.T) {
 	t.Run("Append adds elements to the end of a list", func(t *testing.T) {
-		list := NewLinkedList()
+		list := NewLinkedList[int]()
 
 		list.Append(10)
 		list.Append(12)
@@ -70,7 +74,7 @@ func TestLinkedList_Append(t *testing.T) {
 
 func TestLinkedList_Prepend(t *testing.T) {
 	t.Run("Prepend adds elements to the front of a list", func(t *testing.T) {
-		list := NewLinkedList()
+		list := NewLinkedList[int]()
 
 		list.Prepend(10)
 		list.Append(12)
@@ -85,7 +89,7 @@ func TestLinkedList_Prepend(t *testing.T) {
 }
 
 func TestLinkedList_Count(t *testing.T) {
-	list := NewLinkedList()
+	list := NewLinkedList[int]()
 	list.Append(10)
 	list.Append(12)
 	list.Append(12)
@@ -97,3 +101,291 @@ func TestLinkedList_Count(t *testing.T) {
 
 	assertEqual(t, count, 4)
 }
+
+func buildList[T any](values ...T) *LinkedList[T] {
+	list := NewLinkedList[T]()
+	for _, value := range values {
+		list.Append(value)
+	}
+	return list
+}
+
+func assertList[T comparable](t *testing.T, list *LinkedList[T], expected ...T) {
+	t.Helper()
+	assertLength(t, list, len(expected))
+	current := list.head
+	for index, value := range expected {
+		if current == nil {
+			t.Fatalf("expected %d nodes, but got: %d", len(expected), index)
+		}
+		if current.Value != value {
+			t.Fatalf("expected %v at index %d, but got: %v", value, index, current.Value)
+		}
+		if current.Next == nil && list.tail != current {
+			t.Fatalf("the last node isn't the tail of a list")
+		}
+		current = current.Next
+	}
+	if current != nil {
+		t.Fatalf("expected %d nodes, but got more", len(expected))
+	}
+	if len(expected) == 0 && (list.head != nil || list.tail != nil) {
+		t.Fatalf("expected an empty list to have no head and tail")
+	}
+}
+
+func TestLinkedList_Get(t *testing.T) {
+	list := buildList(10, 11, 12)
+
+	for index, expected := range []int{10, 11, 12} {
+		actual, err := list.Get(index)
+
+		assertError(t, err, nil)
+		assertEqual(t, actual, expected)
+	}
+
+	_, err := list.Get(3)
+	assertError(t, err, ErrorIndexOutOfRange)
+
+	_, err = list.Get(-1)
+	assertError(t, err, ErrorIndexOutOfRange)
+}
+
+func TestLinkedList_IndexOf(t *testing.T) {
+	list := buildList(10, 11, 12, 11)
+
+	assertEqual(t, list.IndexOf(10), 0)
+	assertEqual(t, list.IndexOf(11), 1)
+	assertEqual(t, list.IndexOf(13), -1)
+	assertEqual(t, list.Contains(12), true)
+	assertEqual(t, list.Contains(13), false)
+	assertEqual(t, NewLinkedList[int]().Contains(0), false)
+}
+
+func TestLinkedList_RemoveFirst(t *testing.T) {
+	t.Run("RemoveFirst takes elements from the front of a list", func(t *testing.T) {
+		list := buildList(10, 11, 12)
+
+		value, err := list.RemoveFirst()
+
+		assertError(t, err, nil)
+		assertEqual(t, value, 10)
+		assertList(t, list, 11, 12)
+	})
+
+	t.Run("RemoveFirst of the only element empties a list", func(t *testing.T) {
+		list := buildList(10)
+
+		value, err := list.RemoveFirst()
+
+		assertError(t, err, nil)
+		assertEqual(t, value, 10)
+		assertList(t, list)
+
+		list.Append(11)
+		assertList(t, list, 11)
+	})
+
+	t.Run("RemoveFirst throws an error on an empty list", func(t *testing.T) {
+		_, err := NewLinkedList[int]().RemoveFirst()
+
+		assertError(t, err, ErrorEmptyList)
+	})
+}
+
+func TestLinkedList_RemoveLast(t *testing.T) {
+	t.Run("RemoveLast takes elements from the end of a list", func(t *testing.T) {
+		list := buildList(10, 11, 12)
+
+		value, err := list.RemoveLast()
+
+		assertError(t, err, nil)
+		assertEqual(t, value, 12)
+		assertList(t, list, 10, 11)
+
+		list.Append(13)
+		assertList(t, list, 10, 11, 13)
+	})
+
+	t.Run("RemoveLast of the only element empties a list", func(t *testing.T) {
+		list := buildList(10)
+
+		value, err := list.RemoveLast()
+
+		assertError(t, err, nil)
+		assertEqual(t, value, 10)
+		assertList(t, list)
+
+		list.Prepend(11)
+		assertList(t, list, 11)
+	})
+
+	t.Run("RemoveLast throws an error on an empty list", func(t *testing.T) {
+		_, err := NewLinkedList[int]().RemoveLast()
+
+		assertError(t, err, ErrorEmptyList)
+	})
+}
+
+func TestLinkedList_RemoveAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		index    int
+		err      error
+		value    int
+		expected []int
+	}{
+		{name: "remove the head", index: 0, value: 10, expected: []int{11, 12}},
+		{name: "remove from the middle", index: 1, value: 11, expected: []int{10, 12}},
+		{name: "remove the tail", index: 2, value: 12, expected: []int{10, 11}},
+		{name: "remove beyond the end", index: 3, err: ErrorIndexOutOfRange, expected: []int{10, 11, 12}},
+		{name: "remove a negative index", index: -1, err: ErrorIndexOutOfRange, expected: []int{10, 11, 12}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildList(10, 11, 12)
+
+			value, err := list.RemoveAt(test.index)
+
+			assertError(t, err, test.err)
+			assertEqual(t, value, test.value)
+			assertList(t, list, test.expected...)
+		})
+	}
+}
+
+func TestLinkedList_Remove(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		value    int
+		removed  bool
+		expected []int
+	}{
+		{name: "remove the first occurrence", values: []int{10, 11, 10}, value: 10, removed: true, expected: []int{11, 10}},
+		{name: "remove the tail", values: []int{10, 11, 12}, value: 12, removed: true, expected: []int{10, 11}},
+		{name: "remove the only element", values: []int{10}, value: 10, removed: true, expected: []int{}},
+		{name: "remove a missing element", values: []int{10, 11}, value: 12, removed: false, expected: []int{10, 11}},
+		{name: "remove from an empty list", values: []int{}, value: 12, removed: false, expected: []int{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildList(test.values...)
+
+			removed := list.Remove(test.value)
+
+			assertEqual(t, removed, test.removed)
+			assertList(t, list, test.expected...)
+		})
+	}
+}
+
+func TestLinkedList_Clear(t *testing.T) {
+	list := buildList(10, 11, 12)
+
+	list.Clear()
+
+	assertList(t, list)
+	_, err := list.PeekFirst()
+	assertError(t, err, ErrorEmptyList)
+
+	list.Append(13)
+	assertList(t, list, 13)
+}
+
+type user struct {
+	name  string
+	roles []string
+}
+
+func TestLinkedList_Equality(t *testing.T) {
+	t.Run("uncomparable values are compared structurally", func(t *testing.T) {
+		list := buildList([]int{1, 2}, []int{3}, []int{1, 2})
+
+		assertEqual(t, list.Count([]int{1, 2}), 2)
+		assertEqual(t, list.IndexOf([]int{3}), 1)
+		assertEqual(t, list.Contains([]int{4}), false)
+	})
+
+	t.Run("structs with uncomparable fields are compared structurally", func(t *testing.T) {
+		list := buildList(user{name: "alice", roles: []string{"admin"}}, user{name: "bob"})
+
+		assertEqual(t, list.Count(user{name: "alice", roles: []string{"admin"}}), 1)
+		assertEqual(t, list.Remove(user{name: "bob"}), true)
+		assertLength(t, list, 1)
+	})
+
+	t.Run("interface values holding uncomparable values", func(t *testing.T) {
+		list := buildList[any](1, []int{1}, "1")
+
+		assertEqual(t, list.IndexOf([]int{1}), 1)
+		assertEqual(t, list.IndexOf("1"), 2)
+		assertEqual(t, list.Count(1), 1)
+	})
+
+	t.Run("a custom equality", func(t *testing.T) {
+		byName := func(a, b user) bool { return a.name == b.name }
+		list := buildList(user{name: "alice", roles: []string{"admin"}}, user{name: "bob"}, user{name: "alice"})
+
+		assertEqual(t, list.Count(user{name: "alice"}, byName), 2)
+		assertEqual(t, list.IndexOf(user{name: "bob", roles: []string{"guest"}}, byName), 1)
+		assertEqual(t, list.Contains(user{name: "carol"}, byName), false)
+		assertEqual(t, list.Remove(user{name: "alice"}, byName), true)
+		assertEqual(t, list.Count(user{name: "alice"}, byName), 1)
+	})
+
+	t.Run("a comparable list", func(t *testing.T) {
+		list := NewComparableLinkedList[string]()
+		list.Append("a")
+		list.Append("b")
+		list.Append("a")
+
+		assertEqual(t, list.Count("a"), 2)
+		assertEqual(t, list.IndexOf("b"), 1)
+		assertEqual(t, list.Count("A", strings.EqualFold), 2)
+	})
+
+	t.Run("structs and arrays holding interfaces", func(t *testing.T) {
+		type boxed struct{ v any }
+		structs := NewLinkedList[boxed]()
+		structs.Append(boxed{[]int{1}})
+		structs.Append(boxed{1})
+
+		assertEqual(t, structs.Count(boxed{[]int{1}}), 1)
+		assertEqual(t, structs.IndexOf(boxed{1}), 1)
+
+		arrays := NewLinkedList[[1]any]()
+		arrays.Append([1]any{map[string]int{"a": 1}})
+
+		assertEqual(t, arrays.Contains([1]any{map[string]int{"a": 1}}), true)
+	})
+
+	t.Run("a zero value list", func(t *testing.T) {
+		var list LinkedList[[]int]
+		list.Append([]int{1})
+
+		assertEqual(t, list.Contains([]int{1}), true)
+	})
+
+	t.Run("concurrent searches in a zero value list", func(t *testing.T) {
+		var list LinkedList[int]
+		list.Append(1)
+		list.Append(2)
+
+		var group sync.WaitGroup
+		for worker := 0; worker < 4; worker++ {
+			group.Add(1)
+			go func() {
+				defer group.Done()
+				_ = list.Count(1)
+				_ = list.IndexOf(2)
+				_ = list.Contains(3)
+			}()
+		}
+		group.Wait()
+
+		assertEqual(t, list.IndexOf(2), 1)
+	})
+}
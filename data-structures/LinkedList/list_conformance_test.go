@@ -0,0 +1,225 @@
+package LinkedList
+
+import "testing"
+
+// list is the behaviour shared by every singly and doubly linked list variant.
+type list[T any] interface {
+	Size() int
+	Append(value T)
+	Prepend(value T)
+	PeekFirst() (T, error)
+	PeekLast() (T, error)
+	Get(index int) (T, error)
+	RemoveFirst() (T, error)
+	RemoveLast() (T, error)
+	Count(value T, equal ...func(a, b T) bool) int
+	Contains(value T, equal ...func(a, b T) bool) bool
+	IndexOf(value T, equal ...func(a, b T) bool) int
+	Clear()
+}
+
+var listVariants = []struct {
+	name    string
+	newList func() list[int]
+}{
+	{name: "LinkedList", newList: func() list[int] { return NewLinkedList[int]() }},
+	{name: "CircularLinkedList", newList: func() list[int] { return NewCircularLinkedList[int]() }},
+	{name: "XORLinkedList", newList: func() list[int] { return NewXORLinkedList[int]() }},
+}
+
+func assertListElements(t *testing.T, list list[int], expected ...int) {
+	t.Helper()
+	assertLength(t, list, len(expected))
+	for index, value := range expected {
+		actual, err := list.Get(index)
+		assertError(t, err, nil)
+		assertEqual(t, actual, value)
+	}
+	if len(expected) > 0 {
+		first, _ := list.PeekFirst()
+		last, _ := list.PeekLast()
+		assertEqual(t, first, expected[0])
+		assertEqual(t, last, expected[len(expected)-1])
+	}
+}
+
+// TestListConformance runs the scenarios of linked_list_test.go against every
+// list variant, so they behave the same way.
+func TestListConformance(t *testing.T) {
+	for _, variant := range listVariants {
+		t.Run(variant.name, func(t *testing.T) {
+			testListConformance(t, variant.newList)
+		})
+	}
+}
+
+func testListConformance(t *testing.T, newList func() list[int]) {
+	fill := func(values ...int) list[int] {
+		list := newList()
+		for _, value := range values {
+			list.Append(value)
+		}
+		return list
+	}
+
+	t.Run("Build a linked list", func(t *testing.T) {
+		assertListElements(t, newList())
+	})
+
+	t.Run("Peek throws an error on an empty list", func(t *testing.T) {
+		list := newList()
+
+		_, err := list.PeekFirst()
+		assertError(t, err, ErrorEmptyList)
+
+		_, err = list.PeekLast()
+		assertError(t, err, ErrorEmptyList)
+		assertLength(t, list, 0)
+	})
+
+	t.Run("Append adds elements to the end of a list", func(t *testing.T) {
+		list := newList()
+
+		list.Append(10)
+		list.Append(12)
+		list.Append(13)
+
+		assertListElements(t, list, 10, 12, 13)
+	})
+
+	t.Run("Prepend adds elements to the front of a list", func(t *testing.T) {
+		list := newList()
+
+		list.Prepend(10)
+		list.Append(12)
+		list.Prepend(13)
+
+		assertListElements(t, list, 13, 10, 12)
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		list := fill(10, 12, 12, 12, 12, 13)
+
+		assertEqual(t, list.Count(12), 4)
+		assertEqual(t, list.Count(14), 0)
+		assertEqual(t, list.Count(2, func(a, b int) bool { return a%10 == b%10 }), 4)
+	})
+
+	t.Run("Get out of range", func(t *testing.T) {
+		list := fill(10)
+
+		_, err := list.Get(1)
+		assertError(t, err, ErrorIndexOutOfRange)
+
+		_, err = list.Get(-1)
+		assertError(t, err, ErrorIndexOutOfRange)
+	})
+
+	t.Run("IndexOf and Contains", func(t *testing.T) {
+		list := fill(10, 11, 12, 11)
+
+		assertEqual(t, list.IndexOf(10), 0)
+		assertEqual(t, list.IndexOf(11), 1)
+		assertEqual(t, list.IndexOf(13), -1)
+		assertEqual(t, list.Contains(12), true)
+		assertEqual(t, list.Contains(13), false)
+	})
+
+	t.Run("RemoveFirst and RemoveLast take elements from both ends", func(t *testing.T) {
+		list := fill(10, 11, 12, 13)
+
+		first, err := list.RemoveFirst()
+		assertError(t, err, nil)
+		assertEqual(t, first, 10)
+
+		last, err := list.RemoveLast()
+		assertError(t, err, nil)
+		assertEqual(t, last, 13)
+
+		assertListElements(t, list, 11, 12)
+	})
+
+	t.Run("Removing the only element empties a list", func(t *testing.T) {
+		for _, remove := range []func(list[int]) (int, error){
+			list[int].RemoveFirst,
+			list[int].RemoveLast,
+		} {
+			list := fill(10)
+
+			value, err := remove(list)
+
+			assertError(t, err, nil)
+			assertEqual(t, value, 10)
+			assertListElements(t, list)
+
+			list.Append(11)
+			list.Prepend(12)
+			assertListElements(t, list, 12, 11)
+		}
+	})
+
+	t.Run("Remove throws an error on an empty list", func(t *testing.T) {
+		list := newList()
+
+		_, err := list.RemoveFirst()
+		assertError(t, err, ErrorEmptyList)
+
+		_, err = list.RemoveLast()
+		assertError(t, err, ErrorEmptyList)
+	})
+
+	t.Run("Clear removes all elements", func(t *testing.T) {
+		list := fill(10, 11, 12)
+
+		list.Clear()
+
+		assertListElements(t, list)
+		list.Append(13)
+		assertListElements(t, list, 13)
+	})
+
+	t.Run("Alternating operations", func(t *testing.T) {
+		list, expected := newList(), []int{}
+		for i := 0; i < 50; i++ {
+			switch i % 5 {
+			case 0, 1:
+				list.Append(i)
+				expected = append(expected, i)
+			case 2:
+				list.Prepend(i)
+				expected = append([]int{i}, expected...)
+			case 3:
+				_, _ = list.RemoveFirst()
+				expected = expected[1:]
+			case 4:
+				_, _ = list.RemoveLast()
+				expected = expected[:len(expected)-1]
+			}
+		}
+
+		assertListElements(t, list, expected...)
+	})
+}
+
+// TestListDefaultEquality checks every variant compares values holding
+// uncomparable interfaces structurally instead of panicking on ==.
+func TestListDefaultEquality(t *testing.T) {
+	type boxed struct{ v any }
+	variants := map[string]func() list[boxed]{
+		"LinkedList":         func() list[boxed] { return NewLinkedList[boxed]() },
+		"CircularLinkedList": func() list[boxed] { return NewCircularLinkedList[boxed]() },
+		"XORLinkedList":      func() list[boxed] { return NewXORLinkedList[boxed]() },
+	}
+
+	for name, newList := range variants {
+		t.Run(name, func(t *testing.T) {
+			list := newList()
+			list.Append(boxed{[]int{1}})
+			list.Append(boxed{[]int{2}})
+
+			assertEqual(t, list.Count(boxed{[]int{1}}), 1)
+			assertEqual(t, list.IndexOf(boxed{[]int{2}}), 1)
+			assertEqual(t, list.Contains(boxed{[]int{3}}), false)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package LinkedList
+
+import "iter"
+
+type persistentNode[T any] struct {
+	value  T
+	next   *persistentNode[T]
+	length int
+}
+
+// PersistentList is an immutable singly linked list. Operations never change
+// existing nodes, they return new versions of a list instead, which share as
+// many nodes with the original one as possible. Every version stays valid, so
+// a list can be kept as a snapshot, e.g. in an undo history. The zero value is
+// an empty list.
+type PersistentList[T any] struct {
+	head *persistentNode[T]
+}
+
+// NewPersistentList builds a persistent list of given values in the same order.
+func NewPersistentList[T any](values ...T) PersistentList[T] {
+	var list PersistentList[T]
+	for index := len(values) - 1; index >= 0; index-- {
+		list = list.Cons(values[index])
+	}
+	return list
+}
+
+// Cons returns a new list with a given value in front of a list. The new list
+// shares all nodes of the original one, so it takes constant time.
+func (l PersistentList[T]) Cons(value T) PersistentList[T] {
+	return PersistentList[T]{head: &persistentNode[T]{
+		value:  value,
+		next:   l.head,
+		length: l.Len() + 1,
+	}}
+}
+
+// Head returns the first element of a list. If a list is empty returns an error.
+func (l PersistentList[T]) Head() (T, error) {
+	if l.head == nil {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	return l.head.value, nil
+}
+
+// Tail returns a list without its first element, which shares all nodes with
+// the original one. If a list is empty returns an error.
+func (l PersistentList[T]) Tail() (PersistentList[T], error) {
+	if l.head == nil {
+		return l, ErrorEmptyList
+	}
+	return PersistentList[T]{head: l.head.next}, nil
+}
+
+// Len returns the number of elements in a list in constant time.
+func (l PersistentList[T]) Len() int {
+	if l.head == nil {
+		return 0
+	}
+	return l.head.length
+}
+
+// IsEmpty reports whether a list has no elements.
+func (l PersistentList[T]) IsEmpty() bool {
+	return l.head == nil
+}
+
+// Reverse returns a new list with elements in the reverse order. Every node has
+// to be copied, since the last node of a list becomes the first one.
+func (l PersistentList[T]) Reverse() PersistentList[T] {
+	var reversed PersistentList[T]
+	for value := range l.All() {
+		reversed = reversed.Cons(value)
+	}
+	return reversed
+}
+
+// Map returns a new list with results of calling a function on every element.
+func (l PersistentList[T]) Map(f func(T) T) PersistentList[T] {
+	mapped := make([]T, 0, l.Len())
+	for value := range l.All() {
+		mapped = append(mapped, f(value))
+	}
+	return NewPersistentList(mapped...)
+}
+
+// All returns an iterator over elements of a list from the first to the last one.
+func (l PersistentList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.next {
+			if !yield(current.value) {
+				return
+			}
+		}
+	}
+}
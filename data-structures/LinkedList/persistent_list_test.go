@@ -0,0 +1,114 @@
+package LinkedList
+
+import "testing"
+
+func assertPersistentList(t *testing.T, list PersistentList[int], expected ...int) {
+	t.Helper()
+	assertEqual(t, list.Len(), len(expected))
+	assertEqual(t, list.IsEmpty(), len(expected) == 0)
+	index := 0
+	for value := range list.All() {
+		if index >= len(expected) || value != expected[index] {
+			t.Fatalf("expected %v, but got %d at index %d", expected, value, index)
+		}
+		index++
+	}
+	assertEqual(t, index, len(expected))
+}
+
+func TestPersistentList_Empty(t *testing.T) {
+	var list PersistentList[int]
+
+	_, err := list.Head()
+	assertError(t, err, ErrorEmptyList)
+
+	tail, err := list.Tail()
+	assertError(t, err, ErrorEmptyList)
+	assertPersistentList(t, tail)
+	assertPersistentList(t, list)
+	assertPersistentList(t, NewPersistentList[int]())
+}
+
+func TestPersistentList_Cons(t *testing.T) {
+	empty := NewPersistentList[int]()
+	one := empty.Cons(1)
+	two := one.Cons(2)
+	branch := one.Cons(3)
+
+	assertPersistentList(t, empty)
+	assertPersistentList(t, one, 1)
+	assertPersistentList(t, two, 2, 1)
+	assertPersistentList(t, branch, 3, 1)
+
+	// Both versions share the tail with the original list.
+	assertEqual(t, two.head.next, one.head)
+	assertEqual(t, branch.head.next, one.head)
+}
+
+func TestPersistentList_HeadTail(t *testing.T) {
+	list := NewPersistentList(1, 2, 3)
+
+	head, err := list.Head()
+	assertError(t, err, nil)
+	assertEqual(t, head, 1)
+
+	tail, err := list.Tail()
+	assertError(t, err, nil)
+	assertPersistentList(t, tail, 2, 3)
+	assertEqual(t, tail.head, list.head.next)
+
+	assertPersistentList(t, list, 1, 2, 3)
+}
+
+func TestPersistentList_Reverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{name: "empty list", values: []int{}, expected: []int{}},
+		{name: "single element", values: []int{1}, expected: []int{1}},
+		{name: "many elements", values: []int{1, 2, 3, 4}, expected: []int{4, 3, 2, 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := NewPersistentList(test.values...)
+
+			reversed := list.Reverse()
+
+			assertPersistentList(t, reversed, test.expected...)
+			assertPersistentList(t, list, test.values...)
+		})
+	}
+}
+
+func TestPersistentList_Map(t *testing.T) {
+	list := NewPersistentList(1, 2, 3)
+
+	doubled := list.Map(func(value int) int { return value * 2 })
+
+	assertPersistentList(t, doubled, 2, 4, 6)
+	assertPersistentList(t, list, 1, 2, 3)
+}
+
+func TestPersistentList_UndoHistory(t *testing.T) {
+	history := []PersistentList[int]{NewPersistentList[int]()}
+	for i := 1; i <= 5; i++ {
+		history = append(history, history[len(history)-1].Cons(i))
+	}
+	undone, _ := history[5].Tail()
+	undone, _ = undone.Tail()
+	redone := undone.Cons(10)
+
+	for version, list := range history {
+		expected := make([]int, 0, version)
+		for i := version; i > 0; i-- {
+			expected = append(expected, i)
+		}
+		assertPersistentList(t, list, expected...)
+	}
+	assertPersistentList(t, undone, 3, 2, 1)
+	assertPersistentList(t, redone, 10, 3, 2, 1)
+	assertEqual(t, undone.head, history[3].head)
+}
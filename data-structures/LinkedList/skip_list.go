@@ -0,0 +1,190 @@
+package LinkedList
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	// skipListMaxLevel is enough for 2^32 keys with the promotion probability 1/2.
+	skipListMaxLevel = 32
+)
+
+type skipNode[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*skipNode[K, V]
+}
+
+// SkipList is an ordered map built from linked lists stacked on top of each
+// other. Every level skips over more nodes than the one below it, so search,
+// insertion and deletion take O(log n) time on average.
+type SkipList[K cmp.Ordered, V any] struct {
+	head   *skipNode[K, V]
+	level  int
+	size   int
+	random *rand.Rand
+}
+
+// NewSkipList builds an empty skip list with randomly seeded levels.
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewSkipListWithSeed[K, V](rand.Uint64())
+}
+
+// NewSkipListWithSeed builds an empty skip list, which picks levels of nodes
+// deterministically for a given seed.
+func NewSkipListWithSeed[K cmp.Ordered, V any](seed uint64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:   &skipNode[K, V]{next: make([]*skipNode[K, V], skipListMaxLevel)},
+		level:  1,
+		size:   0,
+		random: rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// Size returns the number of keys in a list.
+func (s *SkipList[K, V]) Size() int {
+	return s.size
+}
+
+// Insert puts a value by a given key. If the key is already in a list, its
+// value is replaced.
+func (s *SkipList[K, V]) Insert(key K, value V) {
+	update := s.predecessors(key)
+	if next := update[0].next[0]; next != nil && next.key == key {
+		next.value = value
+		return
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			update[i] = s.head
+		}
+		s.level = level
+	}
+
+	node := &skipNode[K, V]{key: key, value: value, next: make([]*skipNode[K, V], level)}
+	for i := 0; i < level; i++ {
+		node.next[i], update[i].next[i] = update[i].next[i], node
+	}
+	s.size++
+}
+
+// Delete removes a given key from a list and returns its value. If there is
+// no such key returns an error.
+func (s *SkipList[K, V]) Delete(key K) (V, error) {
+	update := s.predecessors(key)
+	node := update[0].next[0]
+	if node == nil || node.key != key {
+		var zero V
+		return zero, ErrorKeyNotFound
+	}
+
+	for i := 0; i < len(node.next); i++ {
+		update[i].next[i] = node.next[i]
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return node.value, nil
+}
+
+// Search returns a value by a given key. If there is no such key returns an error.
+func (s *SkipList[K, V]) Search(key K) (V, error) {
+	if node := s.ceiling(key); node != nil && node.key == key {
+		return node.value, nil
+	}
+	var zero V
+	return zero, ErrorKeyNotFound
+}
+
+// Contains reports whether a given key is in a list.
+func (s *SkipList[K, V]) Contains(key K) bool {
+	_, err := s.Search(key)
+	return err == nil
+}
+
+// Floor returns the greatest key less than or equal to a given one and its
+// value. If there is no such key returns an error.
+func (s *SkipList[K, V]) Floor(key K) (K, V, error) {
+	node := s.predecessors(key)[0]
+	if next := node.next[0]; next != nil && next.key == key {
+		return next.key, next.value, nil
+	}
+	if node == s.head {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, ErrorKeyNotFound
+	}
+	return node.key, node.value, nil
+}
+
+// Ceiling returns the least key greater than or equal to a given one and its
+// value. If there is no such key returns an error.
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, error) {
+	if node := s.ceiling(key); node != nil {
+		return node.key, node.value, nil
+	}
+	var zeroKey K
+	var zeroValue V
+	return zeroKey, zeroValue, ErrorKeyNotFound
+}
+
+// All returns an iterator over keys and values of a list in ascending order.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return s.iterate(s.head.next[0], func(K) bool { return true })
+}
+
+// Range returns an iterator over keys and values in a half-open range
+// [from, to) in ascending order.
+func (s *SkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return s.iterate(s.ceiling(from), func(key K) bool { return key < to })
+}
+
+func (s *SkipList[K, V]) iterate(start *skipNode[K, V], inRange func(K) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for current := start; current != nil && inRange(current.key); current = current.next[0] {
+			if !yield(current.key, current.value) {
+				return
+			}
+		}
+	}
+}
+
+// predecessors returns the last node with a key less than a given one on
+// every level of a list.
+func (s *SkipList[K, V]) predecessors(key K) []*skipNode[K, V] {
+	update := make([]*skipNode[K, V], skipListMaxLevel)
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && current.next[i].key < key {
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+	return update
+}
+
+// ceiling returns the first node with a key greater than or equal to a given
+// one or nil.
+func (s *SkipList[K, V]) ceiling(key K) *skipNode[K, V] {
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && current.next[i].key < key {
+			current = current.next[i]
+		}
+	}
+	return current.next[0]
+}
+
+// randomLevel promotes a node to every next level with probability 1/2.
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && s.random.IntN(2) == 0 {
+		level++
+	}
+	return level
+}
@@ -0,0 +1,246 @@
+package LinkedList
+
+import "testing"
+
+const testSeed = 42
+
+func buildSkipList(keys ...int) *SkipList[int, string] {
+	list := NewSkipListWithSeed[int, string](testSeed)
+	for _, key := range keys {
+		list.Insert(key, valueOf(key))
+	}
+	return list
+}
+
+func valueOf(key int) string {
+	return string(rune('a' + key%26))
+}
+
+func assertSkipList(t *testing.T, list *SkipList[int, string], expected ...int) {
+	t.Helper()
+	assertLength(t, list, len(expected))
+	index := 0
+	for key, value := range list.All() {
+		if index >= len(expected) || key != expected[index] {
+			t.Fatalf("expected keys %v, but got %d at index %d", expected, key, index)
+		}
+		assertEqual(t, value, valueOf(key))
+		index++
+	}
+	assertEqual(t, index, len(expected))
+
+	// Every level has to be sorted and consist of nodes from the level below.
+	for level := 1; level < list.level; level++ {
+		below := list.head.next[level-1]
+		for current := list.head.next[level]; current != nil; current = current.next[level] {
+			for below != current {
+				if below == nil {
+					t.Fatalf("level %d has a node missing on level %d", level, level-1)
+				}
+				below = below.next[level-1]
+			}
+			if next := current.next[level]; next != nil && next.key <= current.key {
+				t.Fatalf("level %d isn't sorted", level)
+			}
+		}
+	}
+}
+
+func TestSkipList_Insert(t *testing.T) {
+	t.Run("Insert keeps keys sorted", func(t *testing.T) {
+		list := buildSkipList(5, 1, 9, 3, 7, 2, 8, 4, 6, 0)
+
+		assertSkipList(t, list, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	})
+
+	t.Run("Insert replaces a value of an existing key", func(t *testing.T) {
+		list := buildSkipList(1, 2, 3)
+
+		list.Insert(2, "replaced")
+		value, err := list.Search(2)
+
+		assertError(t, err, nil)
+		assertEqual(t, value, "replaced")
+		assertLength(t, list, 3)
+	})
+
+	t.Run("Insert many keys", func(t *testing.T) {
+		list := NewSkipListWithSeed[int, string](testSeed)
+		expected := make([]int, 0, 1000)
+		for i := 999; i >= 0; i-- {
+			list.Insert(i, valueOf(i))
+		}
+		for i := 0; i < 1000; i++ {
+			expected = append(expected, i)
+		}
+
+		assertSkipList(t, list, expected...)
+		if list.level < 2 {
+			t.Errorf("expected a list to have several levels, but got: %d", list.level)
+		}
+	})
+}
+
+func TestSkipList_Seed(t *testing.T) {
+	levels := func(list *SkipList[int, string]) []int {
+		var result []int
+		for current := list.head.next[0]; current != nil; current = current.next[0] {
+			result = append(result, len(current.next))
+		}
+		return result
+	}
+
+	a, b := buildSkipList(1, 2, 3, 4, 5, 6, 7, 8), buildSkipList(1, 2, 3, 4, 5, 6, 7, 8)
+	levelsA, levelsB := levels(a), levels(b)
+
+	for index := range levelsA {
+		assertEqual(t, levelsA[index], levelsB[index])
+	}
+	assertEqual(t, a.level, b.level)
+}
+
+func TestSkipList_Search(t *testing.T) {
+	list := buildSkipList(1, 3, 5)
+
+	for _, key := range []int{1, 3, 5} {
+		value, err := list.Search(key)
+
+		assertError(t, err, nil)
+		assertEqual(t, value, valueOf(key))
+		assertEqual(t, list.Contains(key), true)
+	}
+
+	for _, key := range []int{0, 2, 6} {
+		value, err := list.Search(key)
+
+		assertError(t, err, ErrorKeyNotFound)
+		assertEqual(t, value, "")
+		assertEqual(t, list.Contains(key), false)
+	}
+
+	_, err := NewSkipList[int, string]().Search(1)
+	assertError(t, err, ErrorKeyNotFound)
+}
+
+func TestSkipList_Delete(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      int
+		err      error
+		expected []int
+	}{
+		{name: "delete the first key", key: 1, expected: []int{3, 5, 7}},
+		{name: "delete a middle key", key: 5, expected: []int{1, 3, 7}},
+		{name: "delete the last key", key: 7, expected: []int{1, 3, 5}},
+		{name: "delete a missing key", key: 4, err: ErrorKeyNotFound, expected: []int{1, 3, 5, 7}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildSkipList(1, 3, 5, 7)
+
+			value, err := list.Delete(test.key)
+
+			assertError(t, err, test.err)
+			if test.err == nil {
+				assertEqual(t, value, valueOf(test.key))
+			}
+			assertSkipList(t, list, test.expected...)
+		})
+	}
+
+	t.Run("delete every key", func(t *testing.T) {
+		list := NewSkipListWithSeed[int, string](testSeed)
+		for i := 0; i < 100; i++ {
+			list.Insert(i, valueOf(i))
+		}
+
+		for i := 0; i < 100; i += 2 {
+			_, _ = list.Delete(i)
+		}
+		for i := 1; i < 100; i += 2 {
+			_, _ = list.Delete(i)
+		}
+
+		assertSkipList(t, list)
+		assertEqual(t, list.level, 1)
+	})
+}
+
+func TestSkipList_FloorCeiling(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        int
+		floor      int
+		floorErr   error
+		ceiling    int
+		ceilingErr error
+	}{
+		{name: "before the first key", key: 0, floorErr: ErrorKeyNotFound, ceiling: 10},
+		{name: "the first key", key: 10, floor: 10, ceiling: 10},
+		{name: "between keys", key: 15, floor: 10, ceiling: 20},
+		{name: "a middle key", key: 20, floor: 20, ceiling: 20},
+		{name: "the last key", key: 30, floor: 30, ceiling: 30},
+		{name: "after the last key", key: 35, floor: 30, ceilingErr: ErrorKeyNotFound},
+	}
+
+	list := buildSkipList(10, 20, 30)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			floor, floorValue, err := list.Floor(test.key)
+
+			assertError(t, err, test.floorErr)
+			if err == nil {
+				assertEqual(t, floor, test.floor)
+				assertEqual(t, floorValue, valueOf(test.floor))
+			}
+
+			ceiling, ceilingValue, err := list.Ceiling(test.key)
+
+			assertError(t, err, test.ceilingErr)
+			if err == nil {
+				assertEqual(t, ceiling, test.ceiling)
+				assertEqual(t, ceilingValue, valueOf(test.ceiling))
+			}
+		})
+	}
+}
+
+func TestSkipList_Range(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to int
+		expected []int
+	}{
+		{name: "everything", from: 0, to: 100, expected: []int{10, 20, 30, 40}},
+		{name: "inclusive lower bound", from: 20, to: 40, expected: []int{20, 30}},
+		{name: "bounds between keys", from: 15, to: 35, expected: []int{20, 30}},
+		{name: "empty range", from: 21, to: 29, expected: []int{}},
+		{name: "reversed range", from: 40, to: 10, expected: []int{}},
+	}
+
+	list := buildSkipList(40, 10, 30, 20)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var keys []int
+			for key := range list.Range(test.from, test.to) {
+				keys = append(keys, key)
+			}
+
+			assertEqual(t, len(keys), len(test.expected))
+			for index := range test.expected {
+				assertEqual(t, keys[index], test.expected[index])
+			}
+		})
+	}
+
+	t.Run("stop iteration early", func(t *testing.T) {
+		count := 0
+		for range list.Range(0, 100) {
+			count++
+			break
+		}
+
+		assertEqual(t, count, 1)
+	})
+}
@@ -0,0 +1,93 @@
+package LinkedList
+
+// Concat moves all nodes of other to the end of a list in constant time, so
+// other is left empty. Concatenating a list with itself does nothing.
+func (l *LinkedList[T]) Concat(other *LinkedList[T]) {
+	if other == l || other.head == nil {
+		return
+	}
+	if l.head == nil {
+		l.head = other.head
+	} else {
+		l.tail.Next = other.head
+	}
+	l.tail = other.tail
+	l.size += other.size
+	other.Clear()
+}
+
+// SplitAt moves nodes of a list into two lists: the first one gets elements
+// before a given index and the second one gets the rest, so a list is left
+// empty. An index should be >= 0 and <= size of a list.
+func (l *LinkedList[T]) SplitAt(index int) (*LinkedList[T], *LinkedList[T], error) {
+	if index < 0 || index > l.size {
+		return nil, nil, ErrorIndexOutOfRange
+	}
+	front, back := l.derive(), l.derive()
+	if index == 0 {
+		back.head, back.tail, back.size = l.head, l.tail, l.size
+	} else {
+		last, _ := l.nodeAt(index - 1)
+		front.head, front.tail, front.size = l.head, last, index
+		if last.Next != nil {
+			back.head, back.tail, back.size = last.Next, l.tail, l.size-index
+		}
+		last.Next = nil
+	}
+	l.Clear()
+	return front, back, nil
+}
+
+// Rotate moves the first k elements of a list to its end. A negative k moves the
+// last -k elements to the front instead.
+func (l *LinkedList[T]) Rotate(k int) {
+	if l.size == 0 {
+		return
+	}
+	k %= l.size
+	if k < 0 {
+		k += l.size
+	}
+	if k == 0 {
+		return
+	}
+	last, _ := l.nodeAt(k - 1)
+	l.tail.Next = l.head
+	l.head, l.tail = last.Next, last
+	last.Next = nil
+}
+
+// Partition moves nodes of a list into two lists: the first one gets elements
+// satisfying a predicate and the second one gets the rest, so a list is left
+// empty. Both lists keep the original order of elements.
+func (l *LinkedList[T]) Partition(match func(T) bool) (*LinkedList[T], *LinkedList[T]) {
+	matched, rest := l.derive(), l.derive()
+	for current := l.head; current != nil; {
+		next := current.Next
+		current.Next = nil
+		if match(current.Value) {
+			matched.link(current)
+		} else {
+			rest.link(current)
+		}
+		current = next
+	}
+	l.Clear()
+	return matched, rest
+}
+
+// derive builds an empty list sharing the equality of a list.
+func (l *LinkedList[T]) derive() *LinkedList[T] {
+	return &LinkedList[T]{equal: l.equal}
+}
+
+// link appends a detached node to the end of a list.
+func (l *LinkedList[T]) link(node *Node[T]) {
+	if l.head == nil {
+		l.head = node
+	} else {
+		l.tail.Next = node
+	}
+	l.tail = node
+	l.size++
+}
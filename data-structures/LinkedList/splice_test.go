@@ -0,0 +1,136 @@
+package LinkedList
+
+import "testing"
+
+func TestLinkedList_Concat(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected []int
+	}{
+		{name: "both empty", a: []int{}, b: []int{}, expected: []int{}},
+		{name: "into an empty list", a: []int{}, b: []int{1, 2}, expected: []int{1, 2}},
+		{name: "an empty list", a: []int{1, 2}, b: []int{}, expected: []int{1, 2}},
+		{name: "two lists", a: []int{1, 2}, b: []int{3, 4, 5}, expected: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, b := buildList(test.a...), buildList(test.b...)
+
+			a.Concat(b)
+
+			assertList(t, a, test.expected...)
+			assertList(t, b)
+		})
+	}
+
+	t.Run("concat a list with itself", func(t *testing.T) {
+		list := buildList(1, 2)
+
+		list.Concat(list)
+
+		assertList(t, list, 1, 2)
+	})
+
+	t.Run("an emptied list is reusable", func(t *testing.T) {
+		a, b := buildList(1), buildList(2)
+
+		a.Concat(b)
+		b.Append(3)
+		a.Append(4)
+
+		assertList(t, a, 1, 2, 4)
+		assertList(t, b, 3)
+	})
+}
+
+func TestLinkedList_SplitAt(t *testing.T) {
+	tests := []struct {
+		name          string
+		values        []int
+		index         int
+		err           error
+		front, back   []int
+		expectedLists bool
+	}{
+		{name: "split an empty list", values: []int{}, index: 0, front: []int{}, back: []int{}, expectedLists: true},
+		{name: "split at the head", values: []int{1, 2, 3}, index: 0, front: []int{}, back: []int{1, 2, 3}, expectedLists: true},
+		{name: "split in the middle", values: []int{1, 2, 3}, index: 1, front: []int{1}, back: []int{2, 3}, expectedLists: true},
+		{name: "split before the tail", values: []int{1, 2, 3}, index: 2, front: []int{1, 2}, back: []int{3}, expectedLists: true},
+		{name: "split at the end", values: []int{1, 2, 3}, index: 3, front: []int{1, 2, 3}, back: []int{}, expectedLists: true},
+		{name: "split beyond the end", values: []int{1, 2, 3}, index: 4, err: ErrorIndexOutOfRange},
+		{name: "split at a negative index", values: []int{1, 2, 3}, index: -1, err: ErrorIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildList(test.values...)
+
+			front, back, err := list.SplitAt(test.index)
+
+			assertError(t, err, test.err)
+			if !test.expectedLists {
+				assertList(t, list, test.values...)
+				return
+			}
+			assertList(t, front, test.front...)
+			assertList(t, back, test.back...)
+			assertList(t, list)
+		})
+	}
+}
+
+func TestLinkedList_Rotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		k        int
+		expected []int
+	}{
+		{name: "rotate an empty list", values: []int{}, k: 2, expected: []int{}},
+		{name: "rotate by zero", values: []int{1, 2, 3}, k: 0, expected: []int{1, 2, 3}},
+		{name: "rotate by one", values: []int{1, 2, 3}, k: 1, expected: []int{2, 3, 1}},
+		{name: "rotate by size", values: []int{1, 2, 3}, k: 3, expected: []int{1, 2, 3}},
+		{name: "rotate by more than size", values: []int{1, 2, 3, 4}, k: 6, expected: []int{3, 4, 1, 2}},
+		{name: "rotate backward", values: []int{1, 2, 3, 4}, k: -1, expected: []int{4, 1, 2, 3}},
+		{name: "rotate backward by more than size", values: []int{1, 2, 3}, k: -5, expected: []int{2, 3, 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildList(test.values...)
+
+			list.Rotate(test.k)
+
+			assertList(t, list, test.expected...)
+		})
+	}
+}
+
+func TestLinkedList_Partition(t *testing.T) {
+	isEven := func(value int) bool { return value%2 == 0 }
+	tests := []struct {
+		name    string
+		values  []int
+		matched []int
+		rest    []int
+	}{
+		{name: "partition an empty list", values: []int{}, matched: []int{}, rest: []int{}},
+		{name: "everything matches", values: []int{2, 4}, matched: []int{2, 4}, rest: []int{}},
+		{name: "nothing matches", values: []int{1, 3}, matched: []int{}, rest: []int{1, 3}},
+		{name: "mixed values", values: []int{1, 2, 3, 4, 5, 6}, matched: []int{2, 4, 6}, rest: []int{1, 3, 5}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := buildList(test.values...)
+
+			matched, rest := list.Partition(isEven)
+
+			assertList(t, matched, test.matched...)
+			assertList(t, rest, test.rest...)
+			assertList(t, list)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package LinkedList
+
+import "iter"
+
+// unrolledNodeCapacity is a number of values a single node holds. Values of a
+// node sit next to each other in memory, so scans touch far fewer cache lines
+// and pointers than with a node per value.
+const unrolledNodeCapacity = 32
+
+type unrolledNode[T any] struct {
+	values [unrolledNodeCapacity]T
+	count  int
+	next   *unrolledNode[T]
+}
+
+// UnrolledLinkedList is a linked list where every node stores a small array of
+// values. Full nodes are split in half on insertion, and nodes that become less
+// than half full borrow values from or merge with their successors on removal.
+type UnrolledLinkedList[T any] struct {
+	head *unrolledNode[T]
+	tail *unrolledNode[T]
+	size int
+}
+
+// NewUnrolledLinkedList builds an empty unrolled linked list and returns its pointer.
+func NewUnrolledLinkedList[T any]() *UnrolledLinkedList[T] {
+	return &UnrolledLinkedList[T]{
+		head: nil,
+		tail: nil,
+		size: 0,
+	}
+}
+
+// Size returns the number of values in a list.
+func (l *UnrolledLinkedList[T]) Size() int {
+	return l.size
+}
+
+// Append adds an element to the end of a list. Sequentially appended values
+// fill nodes completely.
+func (l *UnrolledLinkedList[T]) Append(value T) {
+	if l.tail == nil || l.tail.count == unrolledNodeCapacity {
+		l.insertAfter(l.tail, &unrolledNode[T]{})
+	}
+	l.tail.values[l.tail.count] = value
+	l.tail.count++
+	l.size++
+}
+
+// Insert puts an element at a given index shifting the following elements. An
+// index equal to the size of a list appends the element.
+func (l *UnrolledLinkedList[T]) Insert(index int, value T) error {
+	if index < 0 || index > l.size {
+		return ErrorIndexOutOfRange
+	}
+	if index == l.size {
+		l.Append(value)
+		return nil
+	}
+
+	_, node, offset := l.locate(index)
+	if node.count == unrolledNodeCapacity {
+		// Move the upper half of a full node into a new one right after it.
+		half := unrolledNodeCapacity / 2
+		sibling := &unrolledNode[T]{count: unrolledNodeCapacity - half}
+		copy(sibling.values[:], node.values[half:])
+		clear(node.values[half:])
+		node.count = half
+		l.insertAfter(node, sibling)
+		if offset > half {
+			node, offset = sibling, offset-half
+		}
+	}
+
+	copy(node.values[offset+1:node.count+1], node.values[offset:node.count])
+	node.values[offset] = value
+	node.count++
+	l.size++
+	return nil
+}
+
+// Get returns an element by a given index.
+func (l *UnrolledLinkedList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, ErrorIndexOutOfRange
+	}
+	_, node, offset := l.locate(index)
+	return node.values[offset], nil
+}
+
+// Set replaces an element by a given index.
+func (l *UnrolledLinkedList[T]) Set(index int, value T) error {
+	if index < 0 || index >= l.size {
+		return ErrorIndexOutOfRange
+	}
+	_, node, offset := l.locate(index)
+	node.values[offset] = value
+	return nil
+}
+
+// RemoveAt removes an element by a given index and returns it.
+func (l *UnrolledLinkedList[T]) RemoveAt(index int) (T, error) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, ErrorIndexOutOfRange
+	}
+
+	prev, node, offset := l.locate(index)
+	value := node.values[offset]
+	copy(node.values[offset:], node.values[offset+1:node.count])
+	node.count--
+	clear(node.values[node.count : node.count+1])
+	l.size--
+
+	half := unrolledNodeCapacity / 2
+	switch next := node.next; {
+	case node.count == 0:
+		l.removeAfter(prev)
+	case node.count < half && next != nil && node.count+next.count <= unrolledNodeCapacity:
+		copy(node.values[node.count:], next.values[:next.count])
+		node.count += next.count
+		l.removeAfter(node)
+	case node.count < half && next != nil:
+		borrowed := half - node.count
+		copy(node.values[node.count:], next.values[:borrowed])
+		node.count += borrowed
+		copy(next.values[:], next.values[borrowed:next.count])
+		next.count -= borrowed
+		clear(next.values[next.count:])
+	}
+	return value, nil
+}
+
+// All returns an iterator over elements of a list from the first to the last one.
+func (l *UnrolledLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.head; node != nil; node = node.next {
+			for _, value := range node.values[:node.count] {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// locate finds a node holding an element by a valid index, its predecessor and
+// an offset of the element in the node.
+func (l *UnrolledLinkedList[T]) locate(index int) (*unrolledNode[T], *unrolledNode[T], int) {
+	var prev *unrolledNode[T]
+	node := l.head
+	for index >= node.count {
+		index -= node.count
+		prev, node = node, node.next
+	}
+	return prev, node, index
+}
+
+// insertAfter links a node after prev, or at the front of a list if prev is nil.
+func (l *UnrolledLinkedList[T]) insertAfter(prev, node *unrolledNode[T]) {
+	if prev == nil {
+		node.next, l.head = l.head, node
+	} else {
+		node.next, prev.next = prev.next, node
+	}
+	if node.next == nil {
+		l.tail = node
+	}
+}
+
+// removeAfter unlinks a node following prev, or the head if prev is nil.
+func (l *UnrolledLinkedList[T]) removeAfter(prev *unrolledNode[T]) {
+	removed := l.head
+	if prev != nil {
+		removed = prev.next
+		prev.next = removed.next
+	} else {
+		l.head = removed.next
+	}
+	if removed == l.tail {
+		l.tail = prev
+	}
+	removed.next = nil
+}
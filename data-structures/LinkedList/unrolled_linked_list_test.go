@@ -0,0 +1,218 @@
+package LinkedList
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	dynamicarray "github.com/0eu/data-structures-and-algorithms/data-structures/DynamicArray"
+)
+
+func assertUnrolledList(t *testing.T, list *UnrolledLinkedList[int], expected ...int) {
+	t.Helper()
+	assertLength(t, list, len(expected))
+	index := 0
+	for value := range list.All() {
+		if index >= len(expected) || value != expected[index] {
+			t.Fatalf("expected %v, but got %d at index %d", expected, value, index)
+		}
+		index++
+	}
+	assertEqual(t, index, len(expected))
+
+	// Nodes are never empty, and only the last one may be less than half full.
+	for node := list.head; node != nil; node = node.next {
+		if node.count == 0 || (node.next != nil && node.count < unrolledNodeCapacity/2) {
+			t.Fatalf("a node holds %d values", node.count)
+		}
+		if node.next == nil && list.tail != node {
+			t.Fatalf("the last node isn't the tail of a list")
+		}
+	}
+}
+
+func TestUnrolledLinkedList_Append(t *testing.T) {
+	list := NewUnrolledLinkedList[int]()
+	expected := make([]int, 0, 100)
+
+	for i := 0; i < 100; i++ {
+		list.Append(i)
+		expected = append(expected, i)
+	}
+
+	assertUnrolledList(t, list, expected...)
+	for i := 0; i < 100; i++ {
+		actual, err := list.Get(i)
+		assertError(t, err, nil)
+		assertEqual(t, actual, i)
+	}
+}
+
+func TestUnrolledLinkedList_Insert(t *testing.T) {
+	t.Run("insert at the front splits full nodes", func(t *testing.T) {
+		list := NewUnrolledLinkedList[int]()
+		expected := make([]int, 100)
+
+		for i := 0; i < 100; i++ {
+			assertError(t, list.Insert(0, i), nil)
+			expected[99-i] = i
+		}
+
+		assertUnrolledList(t, list, expected...)
+	})
+
+	t.Run("insert into the upper half of a full node", func(t *testing.T) {
+		list := NewUnrolledLinkedList[int]()
+		expected := make([]int, 0, unrolledNodeCapacity+1)
+		for i := 0; i < unrolledNodeCapacity; i++ {
+			list.Append(i)
+			expected = append(expected, i)
+		}
+
+		assertError(t, list.Insert(unrolledNodeCapacity-1, -1), nil)
+		expected = append(expected[:unrolledNodeCapacity-1], -1, unrolledNodeCapacity-1)
+
+		assertUnrolledList(t, list, expected...)
+	})
+
+	t.Run("insert out of range", func(t *testing.T) {
+		list := NewUnrolledLinkedList[int]()
+
+		assertError(t, list.Insert(1, 1), ErrorIndexOutOfRange)
+		assertError(t, list.Insert(-1, 1), ErrorIndexOutOfRange)
+		assertError(t, list.Insert(0, 1), nil)
+		assertUnrolledList(t, list, 1)
+	})
+}
+
+func TestUnrolledLinkedList_GetSet(t *testing.T) {
+	list := NewUnrolledLinkedList[int]()
+	list.Append(1)
+
+	_, err := list.Get(1)
+	assertError(t, err, ErrorIndexOutOfRange)
+
+	assertError(t, list.Set(0, 10), nil)
+	assertError(t, list.Set(1, 10), ErrorIndexOutOfRange)
+	assertUnrolledList(t, list, 10)
+}
+
+func TestUnrolledLinkedList_RemoveAt(t *testing.T) {
+	t.Run("remove from the front merges nodes", func(t *testing.T) {
+		list := NewUnrolledLinkedList[int]()
+		for i := 0; i < 100; i++ {
+			list.Append(i)
+		}
+
+		for i := 0; i < 100; i++ {
+			value, err := list.RemoveAt(0)
+
+			assertError(t, err, nil)
+			assertEqual(t, value, i)
+		}
+
+		assertUnrolledList(t, list)
+		assertEqual(t, list.head, (*unrolledNode[int])(nil))
+	})
+
+	t.Run("remove out of range", func(t *testing.T) {
+		list := NewUnrolledLinkedList[int]()
+
+		_, err := list.RemoveAt(0)
+		assertError(t, err, ErrorIndexOutOfRange)
+	})
+
+	t.Run("random operations keep a list consistent", func(t *testing.T) {
+		random := rand.New(rand.NewPCG(1, 2))
+		list := NewUnrolledLinkedList[int]()
+		var expected []int
+
+		for i := 0; i < 2000; i++ {
+			if len(expected) > 0 && random.IntN(3) == 0 {
+				index := random.IntN(len(expected))
+				value, err := list.RemoveAt(index)
+
+				assertError(t, err, nil)
+				assertEqual(t, value, expected[index])
+				expected = append(expected[:index], expected[index+1:]...)
+			} else {
+				index := random.IntN(len(expected) + 1)
+				assertError(t, list.Insert(index, i), nil)
+				expected = append(expected[:index], append([]int{i}, expected[index:]...)...)
+			}
+		}
+
+		assertUnrolledList(t, list, expected...)
+	})
+}
+
+const benchmarkSize = 1 << 16
+
+func BenchmarkAppend(b *testing.B) {
+	b.Run("LinkedList", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			list := NewLinkedList[int]()
+			for value := 0; value < benchmarkSize; value++ {
+				list.Append(value)
+			}
+		}
+	})
+
+	b.Run("UnrolledLinkedList", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			list := NewUnrolledLinkedList[int]()
+			for value := 0; value < benchmarkSize; value++ {
+				list.Append(value)
+			}
+		}
+	})
+
+	b.Run("DynamicArray", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			array := dynamicarray.NewDynamicArray[int]()
+			for value := 0; value < benchmarkSize; value++ {
+				_ = array.Add(value)
+			}
+		}
+	})
+}
+
+func BenchmarkSequentialScan(b *testing.B) {
+	list := NewLinkedList[int]()
+	unrolled := NewUnrolledLinkedList[int]()
+	array := dynamicarray.NewDynamicArray[int]()
+	for value := 0; value < benchmarkSize; value++ {
+		list.Append(value)
+		unrolled.Append(value)
+		_ = array.Add(value)
+	}
+
+	b.Run("LinkedList", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for current := list.head; current != nil; current = current.Next {
+				sum += current.Value
+			}
+		}
+	})
+
+	b.Run("UnrolledLinkedList", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for value := range unrolled.All() {
+				sum += value
+			}
+		}
+	})
+
+	b.Run("DynamicArray", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for _, value := range array.All() {
+				sum += value
+			}
+		}
+	})
+}
@@ -0,0 +1,217 @@
+package LinkedList
+
+import "iter"
+
+// nilIndex marks the absence of a neighbour, the first slot of an arena is never
+// used by a node.
+const nilIndex = 0
+
+type xorNode[T any] struct {
+	value T
+	// link is an index of the previous node XOR an index of the next one.
+	link int
+}
+
+// XORLinkedList is a doubly linked list that keeps a single link per node: the
+// XOR of indexes of its neighbours. Knowing where traversal came from is enough
+// to find where to go next, so a list can be walked in both directions. Nodes
+// live in an arena, which reuses slots of removed nodes, and are referred to by
+// indexes rather than pointers.
+type XORLinkedList[T any] struct {
+	nodes []xorNode[T]
+	free  []int
+	head  int
+	tail  int
+	size  int
+	equal func(a, b T) bool
+}
+
+// NewXORLinkedList builds an empty XOR linked list and returns its pointer.
+// Searching compares values the same way LinkedList does.
+func NewXORLinkedList[T any]() *XORLinkedList[T] {
+	return &XORLinkedList[T]{
+		nodes: make([]xorNode[T], 1),
+		head:  nilIndex,
+		tail:  nilIndex,
+		size:  0,
+		equal: defaultEqual[T](),
+	}
+}
+
+// Size returns the number of nodes in a list.
+func (l *XORLinkedList[T]) Size() int {
+	return l.size
+}
+
+// Append adds an element to the end of a list.
+func (l *XORLinkedList[T]) Append(value T) {
+	l.tail = l.attach(value, l.tail, &l.head)
+}
+
+// Prepend adds an element to the front of a list.
+func (l *XORLinkedList[T]) Prepend(value T) {
+	l.head = l.attach(value, l.head, &l.tail)
+}
+
+// PeekFirst takes first element of a list. If a list is empty returns an error.
+func (l *XORLinkedList[T]) PeekFirst() (T, error) {
+	return l.peek(l.head)
+}
+
+// PeekLast takes last element of a list. If a list is empty returns an error.
+func (l *XORLinkedList[T]) PeekLast() (T, error) {
+	return l.peek(l.tail)
+}
+
+// Get returns an element by a given index. It walks a list from the closest end.
+func (l *XORLinkedList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, ErrorIndexOutOfRange
+	}
+	start, steps := l.head, index
+	if index > l.size/2 {
+		start, steps = l.tail, l.size-1-index
+	}
+	prev, current := nilIndex, start
+	for ; steps > 0; steps-- {
+		prev, current = current, l.nodes[current].link^prev
+	}
+	return l.nodes[current].value, nil
+}
+
+// RemoveFirst removes the first element of a list and returns it. If a list is
+// empty returns an error.
+func (l *XORLinkedList[T]) RemoveFirst() (T, error) {
+	return l.detach(&l.head, &l.tail)
+}
+
+// RemoveLast removes the last element of a list and returns it. If a list is
+// empty returns an error.
+func (l *XORLinkedList[T]) RemoveLast() (T, error) {
+	return l.detach(&l.tail, &l.head)
+}
+
+// Count returns a number of times a given value is occurred in a list. Values
+// are compared with an optional equality or the list's default one.
+func (l *XORLinkedList[T]) Count(value T, equal ...func(a, b T) bool) int {
+	isEqual, count := chooseEqual(equal, l.equal), 0
+	for element := range l.All() {
+		if isEqual(element, value) {
+			count++
+		}
+	}
+	return count
+}
+
+// Contains reports whether a given value is in a list. Values are compared
+// with an optional equality or the list's default one.
+func (l *XORLinkedList[T]) Contains(value T, equal ...func(a, b T) bool) bool {
+	return l.IndexOf(value, equal...) >= 0
+}
+
+// IndexOf returns an index of the first occurrence of a given value in a list
+// or -1 if there is no such value. Values are compared with an optional
+// equality or the list's default one.
+func (l *XORLinkedList[T]) IndexOf(value T, equal ...func(a, b T) bool) int {
+	isEqual, index := chooseEqual(equal, l.equal), 0
+	for element := range l.All() {
+		if isEqual(element, value) {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Clear removes all elements from a list and releases its arena.
+func (l *XORLinkedList[T]) Clear() {
+	l.nodes, l.free = make([]xorNode[T], 1), nil
+	l.head, l.tail, l.size = nilIndex, nilIndex, 0
+}
+
+// All returns an iterator over elements of a list from the first to the last one.
+func (l *XORLinkedList[T]) All() iter.Seq[T] {
+	return l.walk(l.head)
+}
+
+// Backward returns an iterator over elements of a list from the last to the
+// first one.
+func (l *XORLinkedList[T]) Backward() iter.Seq[T] {
+	return l.walk(l.tail)
+}
+
+func (l *XORLinkedList[T]) walk(start int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		prev, current := nilIndex, start
+		for current != nilIndex {
+			if !yield(l.nodes[current].value) {
+				return
+			}
+			prev, current = current, l.nodes[current].link^prev
+		}
+	}
+}
+
+func (l *XORLinkedList[T]) peek(index int) (T, error) {
+	if index == nilIndex {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	return l.nodes[index].value, nil
+}
+
+// attach links a new node next to an end of a list and returns its index. The
+// opposite end is updated if a list has been empty.
+func (l *XORLinkedList[T]) attach(value T, end int, opposite *int) int {
+	if len(l.nodes) == 0 {
+		l.nodes = make([]xorNode[T], 1)
+	}
+	index := l.allocate(value)
+	l.nodes[index].link = end
+	if end != nilIndex {
+		l.nodes[end].link ^= index
+	} else {
+		*opposite = index
+	}
+	l.size++
+	return index
+}
+
+// detach unlinks a node at an end of a list and returns its value. The
+// opposite end is updated if a list becomes empty.
+func (l *XORLinkedList[T]) detach(end, opposite *int) (T, error) {
+	removed := *end
+	if removed == nilIndex {
+		var zero T
+		return zero, ErrorEmptyList
+	}
+	// A node at an end has no neighbour on one side, so its link is the other one.
+	neighbour := l.nodes[removed].link
+	if neighbour != nilIndex {
+		l.nodes[neighbour].link ^= removed
+	} else {
+		*opposite = nilIndex
+	}
+	*end = neighbour
+	l.size--
+	return l.release(removed), nil
+}
+
+func (l *XORLinkedList[T]) allocate(value T) int {
+	if last := len(l.free) - 1; last >= 0 {
+		index := l.free[last]
+		l.free = l.free[:last]
+		l.nodes[index] = xorNode[T]{value: value}
+		return index
+	}
+	l.nodes = append(l.nodes, xorNode[T]{value: value})
+	return len(l.nodes) - 1
+}
+
+func (l *XORLinkedList[T]) release(index int) T {
+	value := l.nodes[index].value
+	l.nodes[index] = xorNode[T]{}
+	l.free = append(l.free, index)
+	return value
+}
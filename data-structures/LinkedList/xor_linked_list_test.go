@@ -0,0 +1,57 @@
+package LinkedList
+
+import "testing"
+
+func TestXORLinkedList_Traversal(t *testing.T) {
+	list := NewXORLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		list.Append(i)
+	}
+
+	var forward, backward []int
+	for value := range list.All() {
+		forward = append(forward, value)
+	}
+	for value := range list.Backward() {
+		if value == 2 {
+			break
+		}
+		backward = append(backward, value)
+	}
+
+	assertEqual(t, len(forward), 5)
+	for index, value := range forward {
+		assertEqual(t, value, index+1)
+	}
+	assertEqual(t, len(backward), 3)
+	assertEqual(t, backward[0], 5)
+	assertEqual(t, backward[2], 3)
+}
+
+func TestXORLinkedList_Arena(t *testing.T) {
+	t.Run("slots of removed nodes are reused", func(t *testing.T) {
+		list := NewXORLinkedList[int]()
+		for i := 0; i < 10; i++ {
+			list.Append(i)
+		}
+		slots := len(list.nodes)
+
+		for i := 0; i < 100; i++ {
+			_, _ = list.RemoveFirst()
+			list.Append(i)
+		}
+
+		assertEqual(t, len(list.nodes), slots)
+		assertListElements(t, list, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99)
+	})
+
+	t.Run("a zero value list is usable", func(t *testing.T) {
+		var list XORLinkedList[int]
+
+		list.Prepend(1)
+		list.Append(2)
+
+		assertListElements(t, &list, 1, 2)
+		assertEqual(t, list.Contains(2), true)
+	})
+}
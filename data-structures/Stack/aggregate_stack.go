@@ -0,0 +1,177 @@
+package stack
+
+var _ Stack[int] = (*AggregateStack[int])(nil)
+
+type aggregated[T any] struct {
+	value     T
+	aggregate T
+}
+
+// AggregateStack is a stack that keeps an aggregate of its elements under an
+// associative operation, e.g. sum, gcd or any other monoid. Every entry remembers
+// an aggregate of itself and all entries below, so Aggregate takes constant time.
+type AggregateStack[T any] struct {
+	container Stack[aggregated[T]]
+	combine   func(a, b T) T
+}
+
+// NewAggregateStack creates an unbounded aggregate stack. The aggregate of
+// elements e1, e2, ..., en pushed in that order is combine(...combine(e1, e2)..., en).
+func NewAggregateStack[T any](combine func(a, b T) T) *AggregateStack[T] {
+	return &AggregateStack[T]{
+		container: NewArrayStack[aggregated[T]](),
+		combine:   combine,
+	}
+}
+
+// NewAggregateStackWithCapacity creates an aggregate stack that holds at most
+// capacity elements. Capacity should be more than 0.
+func NewAggregateStackWithCapacity[T any](capacity int, combine func(a, b T) T) (*AggregateStack[T], error) {
+	container, err := NewArrayStackWithCapacity[aggregated[T]](capacity)
+	if err != nil {
+		return nil, err
+	}
+	return &AggregateStack[T]{
+		container: container,
+		combine:   combine,
+	}, nil
+}
+
+func (s *AggregateStack[T]) Push(element T) error {
+	entry := aggregated[T]{value: element, aggregate: element}
+	if top, err := s.container.Peek(); err == nil {
+		entry.aggregate = s.combine(top.aggregate, element)
+	}
+	return s.container.Push(entry)
+}
+
+// TryPush puts an element onto a stack and reports whether there was room for it.
+func (s *AggregateStack[T]) TryPush(element T) bool {
+	return s.Push(element) == nil
+}
+
+func (s *AggregateStack[T]) Peek() (T, error) {
+	top, err := s.container.Peek()
+	return top.value, err
+}
+
+func (s *AggregateStack[T]) Pop() (T, error) {
+	top, err := s.container.Pop()
+	return top.value, err
+}
+
+// TryPop takes an element from the top of a stack and reports whether a stack
+// wasn't empty.
+func (s *AggregateStack[T]) TryPop() (T, bool) {
+	top, ok := s.container.TryPop()
+	return top.value, ok
+}
+
+// Aggregate returns an aggregate of all elements of a stack in constant time.
+// If a stack is empty returns an error.
+func (s *AggregateStack[T]) Aggregate() (T, error) {
+	top, err := s.container.Peek()
+	return top.aggregate, err
+}
+
+func (s *AggregateStack[T]) IsFull() bool {
+	return s.container.IsFull()
+}
+
+func (s *AggregateStack[T]) IsEmpty() bool {
+	return s.container.IsEmpty()
+}
+
+func (s *AggregateStack[T]) Size() int {
+	return s.container.Size()
+}
+
+// AggregateQueue is a queue built from two aggregate stacks, which answers an
+// aggregate of its elements in constant time. Elements are enqueued onto one
+// stack and dequeued from the other one, which is refilled in reverse order
+// when it runs out, so every element is moved once and operations take
+// amortized constant time. It suits sliding window aggregates.
+type AggregateQueue[T any] struct {
+	in      *AggregateStack[T]
+	out     *AggregateStack[T]
+	combine func(a, b T) T
+}
+
+// NewAggregateQueue creates an unbounded aggregate queue. The aggregate of
+// elements e1, e2, ..., en from the front to the back is
+// combine(...combine(e1, e2)..., en).
+func NewAggregateQueue[T any](combine func(a, b T) T) *AggregateQueue[T] {
+	return &AggregateQueue[T]{
+		in: NewAggregateStack(combine),
+		// The top of out is the front of a queue, so its aggregate has to be
+		// combined from the top down.
+		out: NewAggregateStack(func(a, b T) T {
+			return combine(b, a)
+		}),
+		combine: combine,
+	}
+}
+
+// Enqueue puts an element to the end of a queue.
+func (q *AggregateQueue[T]) Enqueue(element T) error {
+	return q.in.Push(element)
+}
+
+// Dequeue takes an element from the front of a queue. If a queue is empty
+// returns an error.
+func (q *AggregateQueue[T]) Dequeue() (T, error) {
+	if err := q.refill(); err != nil {
+		var zero T
+		return zero, err
+	}
+	return q.out.Pop()
+}
+
+// Peek returns an element from the front of a queue. If a queue is empty
+// returns an error.
+func (q *AggregateQueue[T]) Peek() (T, error) {
+	if err := q.refill(); err != nil {
+		var zero T
+		return zero, err
+	}
+	return q.out.Peek()
+}
+
+// Aggregate returns an aggregate of all elements of a queue in constant time.
+// If a queue is empty returns an error.
+func (q *AggregateQueue[T]) Aggregate() (T, error) {
+	front, frontErr := q.out.Aggregate()
+	back, backErr := q.in.Aggregate()
+	switch {
+	case frontErr != nil && backErr != nil:
+		var zero T
+		return zero, ErrorEmptyQueue
+	case frontErr != nil:
+		return back, nil
+	case backErr != nil:
+		return front, nil
+	}
+	return q.combine(front, back), nil
+}
+
+func (q *AggregateQueue[T]) IsEmpty() bool {
+	return q.in.IsEmpty() && q.out.IsEmpty()
+}
+
+func (q *AggregateQueue[T]) Size() int {
+	return q.in.Size() + q.out.Size()
+}
+
+// refill moves all elements to the out stack if it is empty.
+func (q *AggregateQueue[T]) refill() error {
+	if !q.out.IsEmpty() {
+		return nil
+	}
+	if q.in.IsEmpty() {
+		return ErrorEmptyQueue
+	}
+	for element, ok := q.in.TryPop(); ok; element, ok = q.in.TryPop() {
+		_ = q.out.Push(element)
+	}
+	return nil
+}
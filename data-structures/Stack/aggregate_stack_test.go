@@ -0,0 +1,155 @@
+package stack
+
+import "testing"
+
+func sum(a, b int) int {
+	return a + b
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func concat(a, b string) string {
+	return a + b
+}
+
+func TestAggregateStack(t *testing.T) {
+	t.Run("Aggregate of empty stack", func(t *testing.T) {
+		stack := NewAggregateStack(sum)
+
+		_, err := stack.Aggregate()
+		assertError(t, err, ErrorEmptyStack)
+	})
+
+	t.Run("Aggregate follows pushes and pops", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			combine  func(a, b int) int
+			values   []int
+			expected []int
+		}{
+			{"sum", sum, []int{1, 2, 3, 4}, []int{1, 3, 6, 10}},
+			{"gcd", gcd, []int{36, 24, 8, 3}, []int{36, 12, 4, 1}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				stack := NewAggregateStack(test.combine)
+				for index, value := range test.values {
+					assertError(t, stack.Push(value), nil)
+					assertAggregate(t, stack, test.expected[index])
+				}
+				for index := len(test.values) - 1; index > 0; index-- {
+					_, err := stack.Pop()
+					assertError(t, err, nil)
+					assertAggregate(t, stack, test.expected[index-1])
+				}
+			})
+		}
+	})
+
+	t.Run("Keeps order of non-commutative operation", func(t *testing.T) {
+		stack := NewAggregateStack(concat)
+		for _, value := range []string{"a", "b", "c"} {
+			assertError(t, stack.Push(value), nil)
+		}
+
+		aggregate, err := stack.Aggregate()
+
+		assertError(t, err, nil)
+		assertEqual(t, aggregate, "abc")
+	})
+}
+
+func TestAggregateQueue(t *testing.T) {
+	t.Run("Empty queue", func(t *testing.T) {
+		queue := NewAggregateQueue(sum)
+
+		_, err := queue.Dequeue()
+		assertError(t, err, ErrorEmptyQueue)
+
+		_, err = queue.Peek()
+		assertError(t, err, ErrorEmptyQueue)
+
+		_, err = queue.Aggregate()
+		assertError(t, err, ErrorEmptyQueue)
+		assertEqual(t, queue.IsEmpty(), true)
+	})
+
+	t.Run("Dequeues in FIFO order", func(t *testing.T) {
+		queue := NewAggregateQueue(sum)
+		for value := 1; value <= 3; value++ {
+			assertError(t, queue.Enqueue(value), nil)
+		}
+		front, err := queue.Dequeue()
+		assertError(t, err, nil)
+		assertEqual(t, front, 1)
+
+		assertError(t, queue.Enqueue(4), nil)
+
+		for _, expected := range []int{2, 3, 4} {
+			assertEqual(t, queue.Size(), 5-expected)
+			value, err := queue.Peek()
+			assertError(t, err, nil)
+			assertEqual(t, value, expected)
+			value, err = queue.Dequeue()
+			assertError(t, err, nil)
+			assertEqual(t, value, expected)
+		}
+		assertEqual(t, queue.IsEmpty(), true)
+	})
+
+	t.Run("Keeps order of non-commutative operation", func(t *testing.T) {
+		queue := NewAggregateQueue(concat)
+		for _, value := range []string{"a", "b", "c"} {
+			assertError(t, queue.Enqueue(value), nil)
+		}
+		_, _ = queue.Dequeue()
+		assertError(t, queue.Enqueue("d"), nil)
+		assertError(t, queue.Enqueue("e"), nil)
+
+		aggregate, err := queue.Aggregate()
+
+		assertError(t, err, nil)
+		assertEqual(t, aggregate, "bcde")
+	})
+
+	t.Run("Sliding window maximum", func(t *testing.T) {
+		values := []int{1, 3, -1, -3, 5, 3, 6, 7}
+		expected := []int{3, 3, 5, 5, 6, 7}
+		window := 3
+
+		queue := NewAggregateQueue(func(a, b int) int {
+			return max(a, b)
+		})
+		var actual []int
+		for index, value := range values {
+			assertError(t, queue.Enqueue(value), nil)
+			if index >= window {
+				_, err := queue.Dequeue()
+				assertError(t, err, nil)
+			}
+			if index >= window-1 {
+				aggregate, err := queue.Aggregate()
+				assertError(t, err, nil)
+				actual = append(actual, aggregate)
+			}
+		}
+
+		assertEqual(t, len(actual), len(expected))
+		for index := range expected {
+			assertEqual(t, actual[index], expected[index])
+		}
+	})
+}
+
+func assertAggregate[T comparable](t *testing.T, stack *AggregateStack[T], expected T) {
+	t.Helper()
+	actual, err := stack.Aggregate()
+	assertError(t, err, nil)
+	assertEqual(t, actual, expected)
+}
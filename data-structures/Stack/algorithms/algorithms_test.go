@@ -0,0 +1,153 @@
+package algorithms
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func assertEqual(t *testing.T, actual, expected interface{}) {
+	t.Helper()
+	if actual != expected {
+		t.Errorf("expected %v, but got: %v", expected, actual)
+	}
+}
+
+func assertIndices(t *testing.T, actual, expected []int) {
+	t.Helper()
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, but got: %v", expected, actual)
+	}
+}
+
+func TestFirstUnbalanced(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"", -1},
+		{"no brackets", -1},
+		{"([]{()})", -1},
+		{"f(a[1], {b: 2})", -1},
+		{")", 0},
+		{"(]", 1},
+		{"([)]", 2},
+		{"(()", 0},
+		{"()(()", 2},
+		{"ф(", 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assertEqual(t, FirstUnbalanced(test.input), test.expected)
+			assertEqual(t, IsBalanced(test.input), test.expected < 0)
+		})
+	}
+
+	t.Run("Custom pairs", func(t *testing.T) {
+		tags := []BracketPair{{'<', '>'}}
+
+		assertEqual(t, IsBalanced("<<a>(b]>", tags...), true)
+		assertEqual(t, FirstUnbalanced("<a>>", tags...), 3)
+	})
+
+	t.Run("Same opening and closing characters", func(t *testing.T) {
+		pairs := []BracketPair{{'(', ')'}, {'|', '|'}}
+
+		assertEqual(t, IsBalanced("|(|x|)|", pairs...), true)
+		assertEqual(t, FirstUnbalanced("(|)|", pairs...), 2)
+		assertEqual(t, FirstUnbalanced("|(|", pairs...), 0)
+	})
+}
+
+func TestNextGreaterAndSmaller(t *testing.T) {
+	values := []int{2, 1, 2, 4, 3, 1}
+
+	assertIndices(t, NextGreater(values), []int{3, 2, 3, -1, -1, -1})
+	assertIndices(t, NextSmaller(values), []int{1, -1, 5, 4, 5, -1})
+	assertIndices(t, NextGreater([]string{"b", "a", "c"}), []int{2, 2, -1})
+	assertIndices(t, NextGreater([]int{}), []int{})
+}
+
+func TestLargestRectangle(t *testing.T) {
+	tests := []struct {
+		name       string
+		heights    []int
+		area       uint64
+		start, end int
+	}{
+		{"empty", nil, 0, 0, 0},
+		{"single bar", []int{5}, 5, 0, 1},
+		{"classic", []int{2, 1, 5, 6, 2, 3}, 10, 2, 4},
+		{"equal bars", []int{3, 3, 3}, 9, 0, 3},
+		{"rising", []int{1, 2, 3, 4, 5}, 9, 2, 5},
+		{"valley", []int{4, 2, 0, 3, 2, 5}, 6, 3, 6},
+		{"negative heights", []int{-1, 2, -3}, 2, 1, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			area, start, end, err := LargestRectangle(test.heights)
+
+			assertEqual(t, err, nil)
+			assertEqual(t, area, test.area)
+			assertEqual(t, start, test.start)
+			assertEqual(t, end, test.end)
+		})
+	}
+
+	t.Run("Area doesn't overflow narrow heights", func(t *testing.T) {
+		heights := make([]int8, 100)
+		for index := range heights {
+			heights[index] = 2
+		}
+
+		area, start, end, err := LargestRectangle(heights)
+
+		assertEqual(t, err, nil)
+		assertEqual(t, area, uint64(200))
+		assertEqual(t, start, 0)
+		assertEqual(t, end, 100)
+	})
+
+	t.Run("Area of large heights is exact", func(t *testing.T) {
+		// Both areas round to 2^53 as float64, so only exact areas tell them apart.
+		heights := []int64{1 << 52, 1 << 52, 0, 1<<53 + 1}
+
+		area, start, end, err := LargestRectangle(heights)
+
+		assertEqual(t, err, nil)
+		assertEqual(t, area, uint64(1<<53+1))
+		assertEqual(t, start, 3)
+		assertEqual(t, end, 4)
+
+		area, _, _, err = LargestRectangle([]int64{math.MaxInt64, math.MaxInt64})
+
+		assertEqual(t, err, nil)
+		assertEqual(t, area, uint64(math.MaxInt64)*2)
+	})
+
+	t.Run("Area beyond uint64", func(t *testing.T) {
+		_, _, _, err := LargestRectangle([]int64{math.MaxInt64, math.MaxInt64, math.MaxInt64})
+
+		assertEqual(t, err, ErrorAreaOverflow)
+	})
+
+	t.Run("Float heights", func(t *testing.T) {
+		area, start, end := LargestRectangleFloat([]float64{1.5, 2.5, 2})
+
+		assertEqual(t, area, 4.5)
+		assertEqual(t, start, 0)
+		assertEqual(t, end, 3)
+	})
+}
+
+func TestStockSpan(t *testing.T) {
+	assertIndices(t, StockSpan([]int{100, 80, 60, 70, 60, 75, 85}), []int{1, 1, 1, 2, 1, 4, 6})
+	assertIndices(t, StockSpan([]float64{1, 1, 1}), []int{1, 2, 3})
+}
+
+func TestDailyTemperatures(t *testing.T) {
+	assertIndices(t, DailyTemperatures([]int{73, 74, 75, 71, 69, 72, 76, 73}), []int{1, 1, 4, 2, 1, 1, 0, 0})
+	assertIndices(t, DailyTemperatures([]float64{30, 30, 29}), []int{0, 0, 0})
+}
@@ -0,0 +1,64 @@
+package algorithms
+
+import "github.com/0eu/data-structures-and-algorithms/data-structures/Stack"
+
+// BracketPair is an opening bracket and a closing one. Both may be the same
+// character, e.g. quotes, then it closes a pair if it is open and opens otherwise.
+type BracketPair struct {
+	Open  rune
+	Close rune
+}
+
+// DefaultBrackets are round, square and curly brackets.
+var DefaultBrackets = []BracketPair{{'(', ')'}, {'[', ']'}, {'{', '}'}}
+
+type bracket struct {
+	char rune
+	pos  int
+}
+
+// IsBalanced reports whether every bracket of an input has a pair and pairs are
+// properly nested. Other characters are ignored. If no pairs are given
+// DefaultBrackets are used.
+func IsBalanced(input string, pairs ...BracketPair) bool {
+	return FirstUnbalanced(input, pairs...) < 0
+}
+
+// FirstUnbalanced returns a byte index of the first bracket of an input that
+// breaks balance: a closing bracket that doesn't match the last open one, or
+// the earliest bracket left open. If an input is balanced returns -1.
+func FirstUnbalanced(input string, pairs ...BracketPair) int {
+	if len(pairs) == 0 {
+		pairs = DefaultBrackets
+	}
+	opening := make(map[rune]rune, len(pairs))
+	closing := make(map[rune]rune, len(pairs))
+	for _, pair := range pairs {
+		opening[pair.Open] = pair.Close
+		closing[pair.Close] = pair.Open
+	}
+
+	brackets := stack.NewArrayStack[bracket]()
+	for pos, char := range input {
+		pair, isClosing := closing[char]
+		_, isOpening := opening[char]
+		if isClosing {
+			if top, err := brackets.Peek(); err == nil && top.char == pair {
+				_, _ = brackets.Pop()
+				continue
+			}
+			if !isOpening {
+				return pos
+			}
+		}
+		if isOpening {
+			_ = brackets.Push(bracket{char: char, pos: pos})
+		}
+	}
+
+	earliest := -1
+	for top, ok := brackets.TryPop(); ok; top, ok = brackets.TryPop() {
+		earliest = top.pos
+	}
+	return earliest
+}
@@ -0,0 +1,159 @@
+package algorithms
+
+import (
+	"cmp"
+	"errors"
+	"math/bits"
+
+	"github.com/0eu/data-structures-and-algorithms/data-structures/Stack"
+)
+
+var (
+	ErrorAreaOverflow = errors.New("an area doesn't fit into uint64")
+)
+
+// Integer is a type of integer values, e.g. heights of bars.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a type of floating-point values, e.g. heights of bars.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a type of numeric values.
+type Number interface {
+	Integer | Float
+}
+
+// NextGreater returns for every value an index of the nearest following value
+// that is strictly greater, or -1 if there is no such value.
+func NextGreater[T cmp.Ordered](values []T) []int {
+	return nextIndices(values, func(current, pending T) bool {
+		return current > pending
+	})
+}
+
+// NextSmaller returns for every value an index of the nearest following value
+// that is strictly smaller, or -1 if there is no such value.
+func NextSmaller[T cmp.Ordered](values []T) []int {
+	return nextIndices(values, func(current, pending T) bool {
+		return current < pending
+	})
+}
+
+// nextIndices keeps a monotonic stack of indices of values which haven't found
+// their next value yet. A current value resolves all pending values it beats,
+// so every index is pushed and popped once.
+func nextIndices[T any](values []T, beats func(current, pending T) bool) []int {
+	next := make([]int, len(values))
+	pending := stack.NewArrayStack[int]()
+	for index, value := range values {
+		for top, err := pending.Peek(); err == nil && beats(value, values[top]); top, err = pending.Peek() {
+			_, _ = pending.Pop()
+			next[top] = index
+		}
+		_ = pending.Push(index)
+	}
+	for top, ok := pending.TryPop(); ok; top, ok = pending.TryPop() {
+		next[top] = -1
+	}
+	return next
+}
+
+// LargestRectangle finds the largest rectangle under a histogram with bars of
+// the given integer heights and width 1. It returns an area of the rectangle and
+// a range [start, end) of bars it spans. An area is computed exactly as uint64,
+// so it doesn't overflow narrow types of heights, and if it doesn't fit into
+// uint64 either, returns an error. Negative heights are treated as 0.
+func LargestRectangle[T Integer](heights []T) (area uint64, start, end int, err error) {
+	return largestRectangle(heights, func(height T, width int) (uint64, error) {
+		high, low := bits.Mul64(uint64(height), uint64(width))
+		if high != 0 {
+			return 0, ErrorAreaOverflow
+		}
+		return low, nil
+	})
+}
+
+// LargestRectangleFloat finds the largest rectangle under a histogram with bars
+// of the given floating-point heights the same way LargestRectangle does. An
+// area is computed as float64. Negative heights are treated as 0.
+func LargestRectangleFloat[T Float](heights []T) (area float64, start, end int) {
+	area, start, end, _ = largestRectangle(heights, func(height T, width int) (float64, error) {
+		return float64(height) * float64(width), nil
+	})
+	return area, start, end
+}
+
+// largestRectangle finds the largest rectangle computing areas of candidates
+// with a given function, which may fail if an area can't be represented.
+func largestRectangle[T Number, A cmp.Ordered](heights []T, rectangle func(height T, width int) (A, error)) (area A, start, end int, err error) {
+	// rising holds indices of bars with non-decreasing heights. A bar below
+	// the top closes rectangles of all higher bars.
+	rising := stack.NewArrayStack[int]()
+	bar := func(index int) T {
+		if index < len(heights) {
+			return max(heights[index], 0)
+		}
+		return 0
+	}
+	for index := 0; index <= len(heights); index++ {
+		height := bar(index)
+		for top, err := rising.Peek(); err == nil && bar(top) >= height; top, err = rising.Peek() {
+			_, _ = rising.Pop()
+			left := 0
+			if below, err := rising.Peek(); err == nil {
+				left = below + 1
+			}
+			candidate, err := rectangle(bar(top), index-left)
+			if err != nil {
+				var zero A
+				return zero, 0, 0, err
+			}
+			if candidate > area {
+				area, start, end = candidate, left, index
+			}
+		}
+		if index < len(heights) {
+			_ = rising.Push(index)
+		}
+	}
+	return area, start, end, nil
+}
+
+// StockSpan returns for every day a span of its price: a number of consecutive
+// days up to and including it with prices not greater than the day's one.
+func StockSpan[T cmp.Ordered](prices []T) []int {
+	spans := make([]int, len(prices))
+	// higher holds indices of days with strictly decreasing prices.
+	higher := stack.NewArrayStack[int]()
+	for index, price := range prices {
+		for top, err := higher.Peek(); err == nil && prices[top] <= price; top, err = higher.Peek() {
+			_, _ = higher.Pop()
+		}
+		previous := -1
+		if top, err := higher.Peek(); err == nil {
+			previous = top
+		}
+		spans[index] = index - previous
+		_ = higher.Push(index)
+	}
+	return spans
+}
+
+// DailyTemperatures returns for every day a number of days to wait for a warmer
+// temperature, or 0 if there is no warmer day later.
+func DailyTemperatures[T cmp.Ordered](temperatures []T) []int {
+	waits := NextGreater(temperatures)
+	for index, next := range waits {
+		if next < 0 {
+			waits[index] = 0
+		} else {
+			waits[index] = next - index
+		}
+	}
+	return waits
+}
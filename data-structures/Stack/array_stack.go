@@ -1,53 +1,149 @@
 package stack
 
-type ArrayStack struct {
-	container []interface{}
-	length    int
+import "iter"
+
+type ArrayStack[T any] struct {
+	container []T
 	capacity  int
 }
 
-func NewArrayStack(capacity int) *ArrayStack {
-	return &ArrayStack{
-		container: make([]interface{}, capacity),
-		length:    0,
-		capacity:  capacity,
+// NewArrayStack creates an unbounded stack, which grows its backing slice as needed.
+func NewArrayStack[T any]() *ArrayStack[T] {
+	return &ArrayStack[T]{
+		container: nil,
+		capacity:  0,
 	}
 }
 
-func (s *ArrayStack) Push(element interface{}) error {
+// NewArrayStackWithCapacity creates a stack that holds at most capacity elements.
+// Capacity should be more than 0.
+func NewArrayStackWithCapacity[T any](capacity int) (*ArrayStack[T], error) {
+	if err := checkCapacity(capacity); err != nil {
+		return nil, err
+	}
+	return &ArrayStack[T]{
+		container: make([]T, 0, capacity),
+		capacity:  capacity,
+	}, nil
+}
+
+func (s *ArrayStack[T]) Push(element T) error {
 	if s.IsFull() {
 		return ErrorExceededCapacity
 	}
-	s.container[s.length] = element
-	s.length++
+	s.container = append(s.container, element)
 	return nil
 }
 
-func (s *ArrayStack) IsFull() bool {
-	return s.length >= s.capacity
+// TryPush puts an element onto a stack and reports whether there was room for it.
+func (s *ArrayStack[T]) TryPush(element T) bool {
+	return s.Push(element) == nil
 }
 
-func (s *ArrayStack) IsEmpty() bool {
-	return s.length == 0
+// IsFull reports whether a bounded stack has reached its capacity. An unbounded
+// stack is never full.
+func (s *ArrayStack[T]) IsFull() bool {
+	return s.capacity > 0 && len(s.container) >= s.capacity
 }
 
-func (s *ArrayStack) Size() int {
-	return s.length
+func (s *ArrayStack[T]) IsEmpty() bool {
+	return len(s.container) == 0
 }
 
-func (s *ArrayStack) Pop() (interface{}, error) {
-	value, err := s.Peek()
-	if err != nil {
-		return nil, err
+func (s *ArrayStack[T]) Size() int {
+	return len(s.container)
+}
+
+func (s *ArrayStack[T]) Pop() (T, error) {
+	value, ok := s.TryPop()
+	if !ok {
+		return value, ErrorEmptyStack
 	}
-	s.container[s.length-1] = nil
-	s.length--
 	return value, nil
 }
 
-func (s *ArrayStack) Peek() (interface{}, error) {
+// TryPop takes an element from the top of a stack and reports whether a stack
+// wasn't empty.
+func (s *ArrayStack[T]) TryPop() (T, bool) {
+	var zero T
+	if s.IsEmpty() {
+		return zero, false
+	}
+	last := len(s.container) - 1
+	value := s.container[last]
+	s.container[last] = zero
+	s.container = s.container[:last]
+	return value, true
+}
+
+func (s *ArrayStack[T]) Peek() (T, error) {
 	if s.IsEmpty() {
-		return nil, ErrorEmptyStack
+		var zero T
+		return zero, ErrorEmptyStack
+	}
+	return s.container[len(s.container)-1], nil
+}
+
+// Iterate returns an iterator over elements of a stack from the top to the
+// bottom. A stack shouldn't be changed during iteration.
+func (s *ArrayStack[T]) Iterate() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for index := len(s.container) - 1; index >= 0; index-- {
+			if !yield(s.container[index]) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a copy of elements of a stack from the top to the bottom.
+func (s *ArrayStack[T]) ToSlice() []T {
+	values := make([]T, 0, len(s.container))
+	for value := range s.Iterate() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// PushAll puts values onto a stack in the given order, so the last one ends up
+// on the top. If all values don't fit into a bounded stack returns an error and
+// pushes nothing.
+func (s *ArrayStack[T]) PushAll(values ...T) error {
+	if s.capacity > 0 && len(s.container)+len(values) > s.capacity {
+		return ErrorExceededCapacity
+	}
+	s.container = append(s.container, values...)
+	return nil
+}
+
+// PopN takes n elements from the top of a stack and returns them from the top
+// down. If a stack has less than n elements returns an error and pops nothing.
+func (s *ArrayStack[T]) PopN(n int) ([]T, error) {
+	if n < 0 || n > len(s.container) {
+		return nil, ErrorWrongCount
+	}
+	rest := len(s.container) - n
+	values := make([]T, n)
+	for index := range values {
+		values[index] = s.container[len(s.container)-1-index]
+	}
+	clear(s.container[rest:])
+	s.container = s.container[:rest]
+	return values, nil
+}
+
+// Clear removes all elements from a stack keeping its backing slice.
+func (s *ArrayStack[T]) Clear() {
+	clear(s.container)
+	s.container = s.container[:0]
+}
+
+// Clone returns an independent copy of a stack with the same capacity.
+func (s *ArrayStack[T]) Clone() *ArrayStack[T] {
+	container := make([]T, len(s.container), cap(s.container))
+	copy(container, s.container)
+	return &ArrayStack[T]{
+		container: container,
+		capacity:  s.capacity,
 	}
-	return s.container[s.length-1], nil
 }
@@ -0,0 +1,108 @@
+package stack
+
+import "sync/atomic"
+
+var _ Stack[int] = (*ConcurrentStack[int])(nil)
+
+// treiberNode is an immutable node of a concurrent stack. It knows a size of
+// a stack it tops, so a size and a capacity check come from a single load.
+type treiberNode[T any] struct {
+	value T
+	next  *treiberNode[T]
+	size  int
+}
+
+// ConcurrentStack is a lock-free stack safe for concurrent use, which is built
+// with the Treiber algorithm: a push or a pop prepares a new top and swaps it in
+// with compare-and-swap, retrying if another goroutine has changed the top.
+// Nodes are never reused, so the garbage collector rules out the ABA problem.
+type ConcurrentStack[T any] struct {
+	head     atomic.Pointer[treiberNode[T]]
+	capacity int
+}
+
+// NewConcurrentStack creates an unbounded concurrent stack.
+func NewConcurrentStack[T any]() *ConcurrentStack[T] {
+	return &ConcurrentStack[T]{}
+}
+
+// NewConcurrentStackWithCapacity creates a concurrent stack that holds at most
+// capacity elements. Capacity should be more than 0.
+func NewConcurrentStackWithCapacity[T any](capacity int) (*ConcurrentStack[T], error) {
+	if err := checkCapacity(capacity); err != nil {
+		return nil, err
+	}
+	return &ConcurrentStack[T]{capacity: capacity}, nil
+}
+
+func (s *ConcurrentStack[T]) Push(element T) error {
+	node := &treiberNode[T]{value: element}
+	for {
+		head := s.head.Load()
+		node.next, node.size = head, head.sizeOf()+1
+		if s.capacity > 0 && node.size > s.capacity {
+			return ErrorExceededCapacity
+		}
+		if s.head.CompareAndSwap(head, node) {
+			return nil
+		}
+	}
+}
+
+// TryPush puts an element onto a stack and reports whether there was room for it.
+func (s *ConcurrentStack[T]) TryPush(element T) bool {
+	return s.Push(element) == nil
+}
+
+func (s *ConcurrentStack[T]) Peek() (T, error) {
+	head := s.head.Load()
+	if head == nil {
+		var zero T
+		return zero, ErrorEmptyStack
+	}
+	return head.value, nil
+}
+
+func (s *ConcurrentStack[T]) Pop() (T, error) {
+	value, ok := s.TryPop()
+	if !ok {
+		return value, ErrorEmptyStack
+	}
+	return value, nil
+}
+
+// TryPop takes an element from the top of a stack and reports whether a stack
+// wasn't empty.
+func (s *ConcurrentStack[T]) TryPop() (T, bool) {
+	for {
+		head := s.head.Load()
+		if head == nil {
+			var zero T
+			return zero, false
+		}
+		if s.head.CompareAndSwap(head, head.next) {
+			return head.value, true
+		}
+	}
+}
+
+// IsFull reports whether a bounded stack has reached its capacity. An unbounded
+// stack is never full. Other goroutines may change a stack right after the check.
+func (s *ConcurrentStack[T]) IsFull() bool {
+	return s.capacity > 0 && s.Size() >= s.capacity
+}
+
+func (s *ConcurrentStack[T]) IsEmpty() bool {
+	return s.head.Load() == nil
+}
+
+func (s *ConcurrentStack[T]) Size() int {
+	return s.head.Load().sizeOf()
+}
+
+func (n *treiberNode[T]) sizeOf() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
@@ -0,0 +1,174 @@
+package stack
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// stressStack runs pushers, each pushing its own range of valuesPerPusher values,
+// alongside poppers, which pop until every pushed value has been popped. It
+// returns how many times every value has been popped.
+func stressStack(t *testing.T, stack Stack[int], pushers, poppers, valuesPerPusher int) []int32 {
+	t.Helper()
+	total := pushers * valuesPerPusher
+	counts := make([]atomic.Int32, total)
+	var popped atomic.Int64
+	var group sync.WaitGroup
+
+	for pusher := 0; pusher < pushers; pusher++ {
+		group.Add(1)
+		go func(first int) {
+			defer group.Done()
+			for value := first; value < first+valuesPerPusher; value++ {
+				for !stack.TryPush(value) {
+					runtime.Gosched()
+				}
+			}
+		}(pusher * valuesPerPusher)
+	}
+	for popper := 0; popper < poppers; popper++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for popped.Load() < int64(total) {
+				if value, ok := stack.TryPop(); ok {
+					counts[value].Add(1)
+					popped.Add(1)
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	group.Wait()
+
+	result := make([]int32, total)
+	for value := range counts {
+		result[value] = counts[value].Load()
+	}
+	return result
+}
+
+func assertPoppedOnce(t *testing.T, counts []int32) {
+	t.Helper()
+	for value, count := range counts {
+		if count != 1 {
+			t.Fatalf("expected value %d to be popped once, but it was popped %d times", value, count)
+		}
+	}
+}
+
+func TestConcurrentStack_Push(t *testing.T) {
+	stack := NewConcurrentStack[int]()
+	pushers := 2 * runtime.GOMAXPROCS(0)
+
+	counts := stressStack(t, stack, pushers, 0, 500)
+
+	assertLength(t, stack, pushers*500)
+	for value, ok := stack.TryPop(); ok; value, ok = stack.TryPop() {
+		counts[value]++
+	}
+	assertPoppedOnce(t, counts)
+}
+
+func TestConcurrentStack_PushPop(t *testing.T) {
+	tests := []struct {
+		name             string
+		pushers, poppers int
+	}{
+		{"one pusher, many poppers", 1, 8},
+		{"many pushers, one popper", 8, 1},
+		{"balanced", 4, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stack := NewConcurrentStack[int]()
+
+			counts := stressStack(t, stack, test.pushers, test.poppers, 2000/test.pushers)
+
+			assertPoppedOnce(t, counts)
+			assertLength(t, stack, 0)
+		})
+	}
+
+	t.Run("bounded stack", func(t *testing.T) {
+		stack, err := NewConcurrentStackWithCapacity[int](16)
+		assertError(t, err, nil)
+
+		counts := stressStack(t, stack, 4, 4, 500)
+
+		assertPoppedOnce(t, counts)
+		assertLength(t, stack, 0)
+	})
+}
+
+func TestConcurrentStack_Capacity(t *testing.T) {
+	const capacity = 100
+	stack, err := NewConcurrentStackWithCapacity[int](capacity)
+	assertError(t, err, nil)
+	var pushed atomic.Int32
+	var group sync.WaitGroup
+
+	for pusher := 0; pusher < 8; pusher++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for value := 0; value < capacity; value++ {
+				if stack.TryPush(value) {
+					pushed.Add(1)
+				}
+				if size := stack.Size(); size > capacity {
+					t.Errorf("size %d exceeds capacity %d", size, capacity)
+				}
+			}
+		}()
+	}
+	group.Wait()
+
+	assertEqual(t, int(pushed.Load()), capacity)
+	assertLength(t, stack, capacity)
+	assertEqual(t, stack.IsFull(), true)
+	assertError(t, stack.Push(1), ErrorExceededCapacity)
+}
+
+// mutexStack guards an ArrayStack with a mutex to compare with the lock-free stack.
+type mutexStack struct {
+	mutex sync.Mutex
+	stack *ArrayStack[int]
+}
+
+func (s *mutexStack) Push(element int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.stack.Push(element)
+}
+
+func (s *mutexStack) TryPop() (int, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.stack.TryPop()
+}
+
+func benchmarkPushPop(b *testing.B, stack interface {
+	Push(element int) error
+	TryPop() (int, bool)
+}) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			_ = stack.Push(i)
+			_, _ = stack.TryPop()
+		}
+	})
+}
+
+func BenchmarkConcurrentStack_PushPop(b *testing.B) {
+	benchmarkPushPop(b, NewConcurrentStack[int]())
+}
+
+func BenchmarkMutexArrayStack_PushPop(b *testing.B) {
+	benchmarkPushPop(b, &mutexStack{stack: NewArrayStack[int]()})
+}
@@ -0,0 +1,125 @@
+package expr
+
+import (
+	"errors"
+
+	"github.com/0eu/data-structures-and-algorithms/data-structures/Stack"
+)
+
+// operand is a computed value and a position of a token it came from.
+type operand struct {
+	value float64
+	pos   int
+}
+
+// EvaluatePostfix computes a value of an expression in postfix notation, e.g.
+// returned by ToPostfix. A lack of operands on a stack is reported as
+// ErrorMissingOperand, while operands that are left over as ErrorMissingOperator.
+func (e *Evaluator) EvaluatePostfix(tokens []Token) (float64, error) {
+	operands := stack.NewArrayStack[operand]()
+	for _, token := range tokens {
+		value, err := e.apply(token, operands)
+		if err != nil {
+			return 0, errorAt(token.Pos, err)
+		}
+		_ = operands.Push(operand{value: value, pos: token.Pos})
+	}
+
+	result, err := operands.Pop()
+	if errors.Is(err, stack.ErrorEmptyStack) {
+		return 0, errorAt(0, ErrorEmptyExpression)
+	}
+	if !operands.IsEmpty() {
+		return 0, errorAt(result.pos, ErrorMissingOperator)
+	}
+	return result.value, nil
+}
+
+// EvaluateRPN computes a value of an expression written in reverse Polish
+// notation, e.g. "3 4 2 * +". Registered functions take as many operands as
+// their arity, so variadic functions can't be used; other names are variables.
+func (e *Evaluator) EvaluateRPN(expression string) (float64, error) {
+	tokens, err := e.tokenize(expression)
+	if err != nil {
+		return 0, err
+	}
+	for index, token := range tokens {
+		switch token.Kind {
+		case VariableToken, FunctionToken:
+			function, ok := e.functions[token.Text]
+			if !ok {
+				tokens[index].Kind = VariableToken
+				continue
+			}
+			if function.Arity == Variadic {
+				return 0, errorAt(token.Pos, ErrorWrongArgumentCount)
+			}
+			tokens[index].Kind, tokens[index].Args = FunctionToken, function.Arity
+		case LeftParenToken, RightParenToken, CommaToken:
+			return 0, errorAt(token.Pos, ErrorUnexpectedToken)
+		}
+	}
+	return e.EvaluatePostfix(tokens)
+}
+
+// apply computes a value of a single token taking its operands from a stack.
+func (e *Evaluator) apply(token Token, operands stack.Stack[operand]) (float64, error) {
+	switch token.Kind {
+	case NumberToken:
+		return token.Value, nil
+	case VariableToken:
+		value, ok := e.variables[token.Text]
+		if !ok {
+			return 0, ErrorUnknownVariable
+		}
+		return value, nil
+	case PrefixToken:
+		operator, ok := e.prefixes[token.Text]
+		if !ok {
+			return 0, ErrorUnknownOperator
+		}
+		args, err := popOperands(operands, 1)
+		if err != nil {
+			return 0, err
+		}
+		return operator.Apply(args[0])
+	case OperatorToken:
+		operator, ok := e.operators[token.Text]
+		if !ok {
+			return 0, ErrorUnknownOperator
+		}
+		args, err := popOperands(operands, 2)
+		if err != nil {
+			return 0, err
+		}
+		return operator.Apply(args[0], args[1])
+	case FunctionToken:
+		function, ok := e.functions[token.Text]
+		if !ok {
+			return 0, ErrorUnknownFunction
+		}
+		if function.Arity != Variadic && function.Arity != token.Args {
+			return 0, ErrorWrongArgumentCount
+		}
+		args, err := popOperands(operands, token.Args)
+		if err != nil {
+			return 0, err
+		}
+		return function.Apply(args...)
+	}
+	return 0, ErrorUnexpectedToken
+}
+
+// popOperands takes n operands from a stack in the order they were pushed. An
+// empty stack means an operator or a function lacks an operand.
+func popOperands(operands stack.Stack[operand], n int) ([]float64, error) {
+	args := make([]float64, n)
+	for index := n - 1; index >= 0; index-- {
+		top, err := operands.Pop()
+		if errors.Is(err, stack.ErrorEmptyStack) {
+			return nil, ErrorMissingOperand
+		}
+		args[index] = top.value
+	}
+	return args, nil
+}
@@ -0,0 +1,165 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrorEmptyExpression       = errors.New("an expression is empty")
+	ErrorUnexpectedCharacter   = errors.New("unexpected character")
+	ErrorUnexpectedToken       = errors.New("unexpected token")
+	ErrorInvalidNumber         = errors.New("invalid number")
+	ErrorMismatchedParentheses = errors.New("mismatched parentheses")
+	ErrorMissingOperand        = errors.New("missing operand")
+	ErrorMissingOperator       = errors.New("missing operator")
+	ErrorUnknownOperator       = errors.New("unknown operator")
+	ErrorUnknownFunction       = errors.New("unknown function")
+	ErrorUnknownVariable       = errors.New("unknown variable")
+	ErrorWrongArgumentCount    = errors.New("wrong number of arguments")
+	ErrorDivisionByZero        = errors.New("division by zero")
+)
+
+// Error is an error that occurred at a given byte offset of an expression.
+type Error struct {
+	Pos int
+	Err error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Err, e.Pos)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func errorAt(pos int, err error) error {
+	return &Error{Pos: pos, Err: err}
+}
+
+// Associativity tells how operators of the same precedence are grouped.
+type Associativity int
+
+const (
+	LeftAssociative Associativity = iota
+	RightAssociative
+)
+
+// Variadic is an arity of functions that take any number of arguments.
+const Variadic = -1
+
+// Operator is a binary infix operator. Operators with a higher precedence bind tighter.
+type Operator struct {
+	Precedence    int
+	Associativity Associativity
+	Apply         func(a, b float64) (float64, error)
+}
+
+// PrefixOperator is a unary operator written before its operand, e.g. a negation.
+type PrefixOperator struct {
+	Precedence int
+	Apply      func(a float64) (float64, error)
+}
+
+// Function is a named function called as name(arg1, arg2, ...).
+type Function struct {
+	Arity int
+	Apply func(args ...float64) (float64, error)
+}
+
+// Evaluator keeps tables of operators, functions and variables which are used
+// to parse and evaluate expressions.
+type Evaluator struct {
+	operators map[string]Operator
+	prefixes  map[string]PrefixOperator
+	functions map[string]Function
+	variables map[string]float64
+}
+
+// NewEvaluator creates an evaluator with arithmetic operators + - * / % ^,
+// unary - and +, functions abs, sqrt, min, max and constants pi and e.
+func NewEvaluator() *Evaluator {
+	return &Evaluator{
+		operators: map[string]Operator{
+			"+": {Precedence: 1, Apply: func(a, b float64) (float64, error) { return a + b, nil }},
+			"-": {Precedence: 1, Apply: func(a, b float64) (float64, error) { return a - b, nil }},
+			"*": {Precedence: 2, Apply: func(a, b float64) (float64, error) { return a * b, nil }},
+			"/": {Precedence: 2, Apply: func(a, b float64) (float64, error) {
+				if b == 0 {
+					return 0, ErrorDivisionByZero
+				}
+				return a / b, nil
+			}},
+			"%": {Precedence: 2, Apply: func(a, b float64) (float64, error) {
+				if b == 0 {
+					return 0, ErrorDivisionByZero
+				}
+				return math.Mod(a, b), nil
+			}},
+			"^": {Precedence: 4, Associativity: RightAssociative, Apply: func(a, b float64) (float64, error) {
+				return math.Pow(a, b), nil
+			}},
+		},
+		prefixes: map[string]PrefixOperator{
+			"-": {Precedence: 3, Apply: func(a float64) (float64, error) { return -a, nil }},
+			"+": {Precedence: 3, Apply: func(a float64) (float64, error) { return a, nil }},
+		},
+		functions: map[string]Function{
+			"abs":  {Arity: 1, Apply: func(args ...float64) (float64, error) { return math.Abs(args[0]), nil }},
+			"sqrt": {Arity: 1, Apply: func(args ...float64) (float64, error) { return math.Sqrt(args[0]), nil }},
+			"min":  {Arity: Variadic, Apply: extremum(math.Min)},
+			"max":  {Arity: Variadic, Apply: extremum(math.Max)},
+		},
+		variables: map[string]float64{
+			"pi": math.Pi,
+			"e":  math.E,
+		},
+	}
+}
+
+func extremum(pick func(a, b float64) float64) func(args ...float64) (float64, error) {
+	return func(args ...float64) (float64, error) {
+		if len(args) == 0 {
+			return 0, ErrorWrongArgumentCount
+		}
+		result := args[0]
+		for _, arg := range args[1:] {
+			result = pick(result, arg)
+		}
+		return result, nil
+	}
+}
+
+// SetOperator registers or replaces a binary operator. A symbol should consist
+// of punctuation characters other than parentheses and a comma.
+func (e *Evaluator) SetOperator(symbol string, operator Operator) {
+	e.operators[symbol] = operator
+}
+
+// SetPrefixOperator registers or replaces a prefix operator. A symbol may be
+// shared with a binary operator, the meaning is taken from a position in an expression.
+func (e *Evaluator) SetPrefixOperator(symbol string, operator PrefixOperator) {
+	e.prefixes[symbol] = operator
+}
+
+// SetFunction registers or replaces a function.
+func (e *Evaluator) SetFunction(name string, function Function) {
+	e.functions[name] = function
+}
+
+// SetVariable sets a value of a variable. Variables are looked up during
+// evaluation, so a parsed expression can be evaluated with different values.
+func (e *Evaluator) SetVariable(name string, value float64) {
+	e.variables[name] = value
+}
+
+// Evaluate parses an infix expression and computes its value.
+func (e *Evaluator) Evaluate(expression string) (float64, error) {
+	postfix, err := e.ToPostfix(expression)
+	if err != nil {
+		return 0, err
+	}
+	return e.EvaluatePostfix(postfix)
+}
@@ -0,0 +1,255 @@
+package expr
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func assertError(t *testing.T, actual, expected error) {
+	t.Helper()
+	if !errors.Is(actual, expected) {
+		t.Errorf("expected error %v, but got: %v", expected, actual)
+	}
+}
+
+func assertEqual(t *testing.T, actual, expected interface{}) {
+	t.Helper()
+	if actual != expected {
+		t.Errorf("expected %v, but got: %v", expected, actual)
+	}
+}
+
+func assertPosition(t *testing.T, err error, expected int) {
+	t.Helper()
+	var exprError *Error
+	if !errors.As(err, &exprError) {
+		t.Fatalf("expected a positioned error, but got: %v", err)
+	}
+	assertEqual(t, exprError.Pos, expected)
+}
+
+func postfixText(tokens []Token) string {
+	texts := make([]string, len(tokens))
+	for index, token := range tokens {
+		texts[index] = token.Text
+		if token.Kind == PrefixToken {
+			texts[index] = "u" + token.Text
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
+func TestToPostfix(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"1 + 2", "1 2 +"},
+		{"3 + 4 * 2", "3 4 2 * +"},
+		{"(3 + 4) * 2", "3 4 + 2 *"},
+		{"8 - 3 - 2", "8 3 - 2 -"},
+		{"2 ^ 3 ^ 2", "2 3 2 ^ ^"},
+		{"-2 ^ 2", "2 2 ^ u-"},
+		{"-2 * 3", "2 u- 3 *"},
+		{"2 ^ -1", "2 1 u- ^"},
+		{"max(1, x + 2, 3) * 2", "1 x 2 + 3 max 2 *"},
+		{"sqrt(abs(-16))", "16 u- abs sqrt"},
+	}
+
+	evaluator := NewEvaluator()
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			postfix, err := evaluator.ToPostfix(test.expression)
+
+			assertError(t, err, nil)
+			assertEqual(t, postfixText(postfix), test.expected)
+		})
+	}
+
+	t.Run("Function call gets a number of arguments", func(t *testing.T) {
+		postfix, err := evaluator.ToPostfix("min(4, 5, 6)")
+
+		assertError(t, err, nil)
+		assertEqual(t, postfix[len(postfix)-1].Args, 3)
+	})
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   float64
+	}{
+		{"42", 42},
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"10 / 4", 2.5},
+		{"10 % 4", 2},
+		{"2 ^ 3 ^ 2", 512},
+		{"-2 ^ 2", -4},
+		{"--3", 3},
+		{"+3 - -3", 6},
+		{"2 * pi", 2 * math.Pi},
+		{"1e3 + 2.5E-1", 1000.25},
+		{"1e+2 * 2", 200},
+		{"min(3, 1, 2) + max(3, 1, 2)", 4},
+		{"sqrt(16) + abs(-2.5)", 6.5},
+	}
+
+	evaluator := NewEvaluator()
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			actual, err := evaluator.Evaluate(test.expression)
+
+			assertError(t, err, nil)
+			assertEqual(t, actual, test.expected)
+		})
+	}
+}
+
+func TestEvaluateRPN(t *testing.T) {
+	evaluator := NewEvaluator()
+
+	t.Run("Evaluates postfix expression", func(t *testing.T) {
+		actual, err := evaluator.EvaluateRPN("3 4 2 * + sqrt 1 -")
+
+		assertError(t, err, nil)
+		assertEqual(t, actual, math.Sqrt(11)-1)
+	})
+
+	t.Run("Stack underflow is a missing operand", func(t *testing.T) {
+		_, err := evaluator.EvaluateRPN("3 +")
+
+		assertError(t, err, ErrorMissingOperand)
+		assertPosition(t, err, 2)
+	})
+
+	t.Run("Leftover operands mean a missing operator", func(t *testing.T) {
+		_, err := evaluator.EvaluateRPN("1 2 3 +")
+
+		assertError(t, err, ErrorMissingOperator)
+		assertPosition(t, err, 6)
+	})
+
+	t.Run("Variadic functions are rejected", func(t *testing.T) {
+		_, err := evaluator.EvaluateRPN("1 2 max")
+
+		assertError(t, err, ErrorWrongArgumentCount)
+		assertPosition(t, err, 4)
+	})
+
+	t.Run("Parentheses are rejected", func(t *testing.T) {
+		_, err := evaluator.EvaluateRPN("(1 2 +)")
+
+		assertError(t, err, ErrorUnexpectedToken)
+		assertPosition(t, err, 0)
+	})
+}
+
+func TestCustomTables(t *testing.T) {
+	t.Run("Variables are looked up on evaluation", func(t *testing.T) {
+		evaluator := NewEvaluator()
+		postfix, err := evaluator.ToPostfix("x * x + y")
+		assertError(t, err, nil)
+
+		for x, expected := range []float64{1, 2, 5} {
+			evaluator.SetVariable("x", float64(x))
+			evaluator.SetVariable("y", 1)
+
+			actual, err := evaluator.EvaluatePostfix(postfix)
+
+			assertError(t, err, nil)
+			assertEqual(t, actual, expected)
+		}
+	})
+
+	t.Run("Functions and operators", func(t *testing.T) {
+		evaluator := NewEvaluator()
+		evaluator.SetFunction("hypot", Function{Arity: 2, Apply: func(args ...float64) (float64, error) {
+			return math.Hypot(args[0], args[1]), nil
+		}})
+		evaluator.SetFunction("answer", Function{Arity: 0, Apply: func(args ...float64) (float64, error) {
+			return 42, nil
+		}})
+		evaluator.SetOperator("**", Operator{Precedence: 4, Associativity: RightAssociative,
+			Apply: func(a, b float64) (float64, error) {
+				return math.Pow(a, b), nil
+			}})
+		evaluator.SetPrefixOperator("!", PrefixOperator{Precedence: 3, Apply: func(a float64) (float64, error) {
+			if a == 0 {
+				return 1, nil
+			}
+			return 0, nil
+		}})
+
+		actual, err := evaluator.Evaluate("hypot(3, 4) + 2 ** 3 * 2 - answer() + !0")
+
+		assertError(t, err, nil)
+		assertEqual(t, actual, 5+16-42+1.0)
+	})
+
+	t.Run("Errors of functions carry a position", func(t *testing.T) {
+		failure := errors.New("failure")
+		evaluator := NewEvaluator()
+		evaluator.SetFunction("fail", Function{Arity: 1, Apply: func(args ...float64) (float64, error) {
+			return 0, failure
+		}})
+
+		_, err := evaluator.Evaluate("1 + fail(2)")
+
+		assertError(t, err, failure)
+		assertPosition(t, err, 4)
+	})
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   error
+		position   int
+	}{
+		{"", ErrorEmptyExpression, 0},
+		{"   ", ErrorEmptyExpression, 0},
+		{"1 + $", ErrorUnexpectedCharacter, 4},
+		{"1..2", ErrorInvalidNumber, 0},
+		{"2e", ErrorInvalidNumber, 1},
+		{"1 + 3E+", ErrorInvalidNumber, 5},
+		{"1e3 e", ErrorMissingOperator, 4},
+		{"(1 + 2", ErrorMismatchedParentheses, 0},
+		{"1 + 2)", ErrorMismatchedParentheses, 5},
+		{"((1) + 2))", ErrorMismatchedParentheses, 9},
+		{"()", ErrorMissingOperand, 1},
+		{"1 +", ErrorMissingOperand, 3},
+		{"* 2", ErrorMissingOperand, 0},
+		{"1 + * 2", ErrorMissingOperand, 4},
+		{"1 2", ErrorMissingOperator, 2},
+		{"2 (3)", ErrorMissingOperator, 2},
+		{"1, 2", ErrorUnexpectedToken, 1},
+		{"(1, 2)", ErrorUnexpectedToken, 2},
+		{"max(1,)", ErrorMissingOperand, 6},
+		{"max(, 1)", ErrorMissingOperand, 4},
+		{"sqrt(1, 2)", ErrorWrongArgumentCount, 9},
+		{"sqrt()", ErrorWrongArgumentCount, 5},
+		{"foo(1)", ErrorUnknownFunction, 0},
+		{"1 + y", ErrorUnknownVariable, 4},
+		{"1 / (2 - 2)", ErrorDivisionByZero, 2},
+		{"min()", ErrorWrongArgumentCount, 0},
+	}
+
+	evaluator := NewEvaluator()
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			_, err := evaluator.Evaluate(test.expression)
+
+			assertError(t, err, test.expected)
+			assertPosition(t, err, test.position)
+		})
+	}
+
+	t.Run("Error message has a position", func(t *testing.T) {
+		_, err := evaluator.Evaluate("1 +")
+
+		assertEqual(t, err.Error(), "missing operand at position 3")
+	})
+}
@@ -0,0 +1,134 @@
+package expr
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TokenKind is a kind of a lexical token.
+type TokenKind int
+
+const (
+	NumberToken TokenKind = iota
+	VariableToken
+	FunctionToken
+	OperatorToken
+	PrefixToken
+	LeftParenToken
+	RightParenToken
+	CommaToken
+)
+
+// Token is a lexical unit of an expression. Pos is a byte offset of a token in
+// an expression, Args is a number of arguments of a function call.
+type Token struct {
+	Kind  TokenKind
+	Text  string
+	Pos   int
+	Value float64
+	Args  int
+}
+
+// tokenize splits an expression into tokens. Identifiers followed by an opening
+// parenthesis are function names, other ones are variables.
+func (e *Evaluator) tokenize(expression string) ([]Token, error) {
+	var tokens []Token
+	for pos := 0; pos < len(expression); {
+		char := expression[pos]
+		switch {
+		case isSpace(char):
+			pos++
+		case isDigit(char) || char == '.':
+			end := pos
+			for end < len(expression) && (isDigit(expression[end]) || expression[end] == '.') {
+				end++
+			}
+			end, err := scanExponent(expression, end)
+			if err != nil {
+				return nil, err
+			}
+			value, err := strconv.ParseFloat(expression[pos:end], 64)
+			if err != nil {
+				return nil, errorAt(pos, ErrorInvalidNumber)
+			}
+			tokens = append(tokens, Token{Kind: NumberToken, Text: expression[pos:end], Pos: pos, Value: value})
+			pos = end
+		case isLetter(char):
+			end := pos
+			for end < len(expression) && (isLetter(expression[end]) || isDigit(expression[end])) {
+				end++
+			}
+			kind := VariableToken
+			if strings.HasPrefix(strings.TrimLeft(expression[end:], " \t\n\r"), "(") {
+				kind = FunctionToken
+			}
+			tokens = append(tokens, Token{Kind: kind, Text: expression[pos:end], Pos: pos})
+			pos = end
+		case char == '(':
+			tokens = append(tokens, Token{Kind: LeftParenToken, Text: "(", Pos: pos})
+			pos++
+		case char == ')':
+			tokens = append(tokens, Token{Kind: RightParenToken, Text: ")", Pos: pos})
+			pos++
+		case char == ',':
+			tokens = append(tokens, Token{Kind: CommaToken, Text: ",", Pos: pos})
+			pos++
+		default:
+			symbol := e.matchOperator(expression[pos:])
+			if symbol == "" {
+				return nil, errorAt(pos, ErrorUnexpectedCharacter)
+			}
+			tokens = append(tokens, Token{Kind: OperatorToken, Text: symbol, Pos: pos})
+			pos += len(symbol)
+		}
+	}
+	return tokens, nil
+}
+
+// scanExponent returns an end of an exponent like e10 or E-3 starting at pos,
+// or pos itself if there is no exponent. An exponent without digits is an error.
+func scanExponent(expression string, pos int) (int, error) {
+	if pos >= len(expression) || expression[pos] != 'e' && expression[pos] != 'E' {
+		return pos, nil
+	}
+	end := pos + 1
+	if end < len(expression) && (expression[end] == '+' || expression[end] == '-') {
+		end++
+	}
+	digits := end
+	for end < len(expression) && isDigit(expression[end]) {
+		end++
+	}
+	if end == digits {
+		return 0, errorAt(pos, ErrorInvalidNumber)
+	}
+	return end, nil
+}
+
+// matchOperator returns the longest operator symbol an input starts with.
+func (e *Evaluator) matchOperator(input string) string {
+	var longest string
+	for symbol := range e.operators {
+		if len(symbol) > len(longest) && strings.HasPrefix(input, symbol) {
+			longest = symbol
+		}
+	}
+	for symbol := range e.prefixes {
+		if len(symbol) > len(longest) && strings.HasPrefix(input, symbol) {
+			longest = symbol
+		}
+	}
+	return longest
+}
+
+func isSpace(char byte) bool {
+	return char == ' ' || char == '\t' || char == '\n' || char == '\r'
+}
+
+func isDigit(char byte) bool {
+	return '0' <= char && char <= '9'
+}
+
+func isLetter(char byte) bool {
+	return 'a' <= char && char <= 'z' || 'A' <= char && char <= 'Z' || char == '_'
+}
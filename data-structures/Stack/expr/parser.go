@@ -0,0 +1,165 @@
+package expr
+
+import (
+	"errors"
+
+	"github.com/0eu/data-structures-and-algorithms/data-structures/Stack"
+)
+
+// ToPostfix converts an infix expression to postfix (reverse Polish) notation
+// with the shunting-yard algorithm. Operators are ordered by their precedence
+// and associativity, function calls get a number of their arguments.
+func (e *Evaluator) ToPostfix(expression string) ([]Token, error) {
+	tokens, err := e.tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, errorAt(0, ErrorEmptyExpression)
+	}
+
+	output := make([]Token, 0, len(tokens))
+	operators := stack.NewArrayStack[Token]()
+	// commas holds a number of commas met inside every open parenthesis, or -1
+	// if a parenthesis only groups a subexpression.
+	commas := stack.NewArrayStack[int]()
+	expectOperand := true
+
+	for index, token := range tokens {
+		switch token.Kind {
+		case NumberToken, VariableToken:
+			if !expectOperand {
+				return nil, errorAt(token.Pos, ErrorMissingOperator)
+			}
+			output = append(output, token)
+			expectOperand = false
+		case FunctionToken:
+			if !expectOperand {
+				return nil, errorAt(token.Pos, ErrorMissingOperator)
+			}
+			if _, ok := e.functions[token.Text]; !ok {
+				return nil, errorAt(token.Pos, ErrorUnknownFunction)
+			}
+			_ = operators.Push(token)
+		case LeftParenToken:
+			if !expectOperand {
+				return nil, errorAt(token.Pos, ErrorMissingOperator)
+			}
+			if index > 0 && tokens[index-1].Kind == FunctionToken {
+				_ = commas.Push(0)
+			} else {
+				_ = commas.Push(-1)
+			}
+			_ = operators.Push(token)
+		case CommaToken:
+			if expectOperand {
+				return nil, errorAt(token.Pos, ErrorMissingOperand)
+			}
+			if output, err = popUntilParen(operators, output); err != nil {
+				return nil, errorAt(token.Pos, ErrorUnexpectedToken)
+			}
+			count, _ := commas.Pop()
+			if count < 0 {
+				return nil, errorAt(token.Pos, ErrorUnexpectedToken)
+			}
+			_ = commas.Push(count + 1)
+			expectOperand = true
+		case RightParenToken:
+			if output, err = popUntilParen(operators, output); err != nil {
+				return nil, errorAt(token.Pos, ErrorMismatchedParentheses)
+			}
+			_, _ = operators.Pop()
+			count, _ := commas.Pop()
+			if count < 0 {
+				if expectOperand {
+					return nil, errorAt(token.Pos, ErrorMissingOperand)
+				}
+			} else {
+				function, _ := operators.Pop()
+				if function.Args, err = e.countArgs(function, count, expectOperand, tokens[index-1]); err != nil {
+					return nil, errorAt(token.Pos, err)
+				}
+				output = append(output, function)
+			}
+			expectOperand = false
+		case OperatorToken:
+			if expectOperand {
+				if _, ok := e.prefixes[token.Text]; !ok {
+					return nil, errorAt(token.Pos, ErrorMissingOperand)
+				}
+				token.Kind = PrefixToken
+				_ = operators.Push(token)
+				continue
+			}
+			operator, ok := e.operators[token.Text]
+			if !ok {
+				return nil, errorAt(token.Pos, ErrorUnknownOperator)
+			}
+			for top, err := operators.Peek(); err == nil && e.popsBefore(top, operator); top, err = operators.Peek() {
+				_, _ = operators.Pop()
+				output = append(output, top)
+			}
+			_ = operators.Push(token)
+			expectOperand = true
+		}
+	}
+
+	if expectOperand {
+		return nil, errorAt(len(expression), ErrorMissingOperand)
+	}
+	for top, ok := operators.TryPop(); ok; top, ok = operators.TryPop() {
+		if top.Kind == LeftParenToken {
+			return nil, errorAt(top.Pos, ErrorMismatchedParentheses)
+		}
+		output = append(output, top)
+	}
+	return output, nil
+}
+
+// popUntilParen moves operators to an output until an opening parenthesis,
+// which is left on a stack. If there is no such parenthesis returns an error.
+func popUntilParen(operators stack.Stack[Token], output []Token) ([]Token, error) {
+	for {
+		top, err := operators.Peek()
+		if errors.Is(err, stack.ErrorEmptyStack) {
+			return output, ErrorMismatchedParentheses
+		}
+		if top.Kind == LeftParenToken {
+			return output, nil
+		}
+		_, _ = operators.Pop()
+		output = append(output, top)
+	}
+}
+
+// popsBefore reports whether an operator on top of a stack has to be applied
+// before a given binary operator.
+func (e *Evaluator) popsBefore(top Token, operator Operator) bool {
+	var precedence int
+	switch top.Kind {
+	case OperatorToken:
+		precedence = e.operators[top.Text].Precedence
+	case PrefixToken:
+		precedence = e.prefixes[top.Text].Precedence
+	default:
+		return false
+	}
+	return precedence > operator.Precedence ||
+		precedence == operator.Precedence && operator.Associativity == LeftAssociative
+}
+
+// countArgs returns a number of arguments of a function call closed after the
+// given last token and checks it against the function's arity.
+func (e *Evaluator) countArgs(function Token, commas int, expectOperand bool, last Token) (int, error) {
+	args := commas + 1
+	if expectOperand {
+		if last.Kind != LeftParenToken {
+			return 0, ErrorMissingOperand
+		}
+		args = 0
+	}
+	if arity := e.functions[function.Text].Arity; arity != Variadic && arity != args {
+		return 0, ErrorWrongArgumentCount
+	}
+	return args, nil
+}
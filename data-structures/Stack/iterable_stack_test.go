@@ -0,0 +1,149 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+)
+
+func assertSlice(t *testing.T, actual, expected []int) {
+	t.Helper()
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected elements %v, but got: %v", expected, actual)
+	}
+}
+
+func initIterableStacks(t *testing.T, size int) map[string]IterableStack[int] {
+	t.Helper()
+	linkedListStack, err := NewLinkedListStackWithCapacity[int](size)
+	assertError(t, err, nil)
+	arrayStack, err := NewArrayStackWithCapacity[int](size)
+	assertError(t, err, nil)
+	return map[string]IterableStack[int]{
+		"LinkedListStack":         NewLinkedListStack[int](),
+		"ArrayStack":              NewArrayStack[int](),
+		"bounded LinkedListStack": linkedListStack,
+		"bounded ArrayStack":      arrayStack,
+	}
+}
+
+func TestIterate(t *testing.T) {
+	for name, stack := range initIterableStacks(t, 5) {
+		t.Run(name, func(t *testing.T) {
+			assertSlice(t, stack.ToSlice(), []int{})
+
+			assertError(t, stack.PushAll(1, 2, 3), nil)
+
+			assertSlice(t, stack.ToSlice(), []int{3, 2, 1})
+			assertSlice(t, slices.Collect(stack.Iterate()), []int{3, 2, 1})
+			assertLength(t, stack, 3)
+
+			for value := range stack.Iterate() {
+				assertEqual(t, value, 3)
+				break
+			}
+		})
+	}
+}
+
+func TestPushAll(t *testing.T) {
+	for name, stack := range initIterableStacks(t, 3) {
+		t.Run(name, func(t *testing.T) {
+			assertError(t, stack.PushAll(1, 2), nil)
+			assertError(t, stack.PushAll(), nil)
+
+			top, err := stack.Peek()
+			assertError(t, err, nil)
+			assertEqual(t, top, 2)
+		})
+	}
+
+	t.Run("Fails atomically on exceeded capacity", func(t *testing.T) {
+		for name, stack := range initIterableStacks(t, 3) {
+			if name != "bounded LinkedListStack" && name != "bounded ArrayStack" {
+				continue
+			}
+			assertError(t, stack.PushAll(1, 2), nil)
+
+			assertError(t, stack.PushAll(3, 4), ErrorExceededCapacity)
+			assertSlice(t, stack.ToSlice(), []int{2, 1})
+
+			assertError(t, stack.PushAll(3), nil)
+			assertEqual(t, stack.IsFull(), true)
+		}
+	})
+}
+
+func TestPopN(t *testing.T) {
+	for name, stack := range initIterableStacks(t, 5) {
+		t.Run(name, func(t *testing.T) {
+			assertError(t, stack.PushAll(1, 2, 3, 4), nil)
+
+			popped, err := stack.PopN(3)
+			assertError(t, err, nil)
+			assertSlice(t, popped, []int{4, 3, 2})
+			assertSlice(t, stack.ToSlice(), []int{1})
+
+			popped, err = stack.PopN(0)
+			assertError(t, err, nil)
+			assertSlice(t, popped, []int{})
+
+			for _, n := range []int{-1, 2} {
+				_, err = stack.PopN(n)
+				assertError(t, err, ErrorWrongCount)
+				assertLength(t, stack, 1)
+			}
+		})
+	}
+}
+
+func TestClear(t *testing.T) {
+	for name, stack := range initIterableStacks(t, 3) {
+		t.Run(name, func(t *testing.T) {
+			assertError(t, stack.PushAll(1, 2, 3), nil)
+
+			stack.Clear()
+
+			assertLength(t, stack, 0)
+			assertEqual(t, stack.IsEmpty(), true)
+			_, err := stack.Peek()
+			assertError(t, err, ErrorEmptyStack)
+			assertError(t, stack.PushAll(4, 5, 6), nil)
+			assertSlice(t, stack.ToSlice(), []int{6, 5, 4})
+		})
+	}
+}
+
+func TestClone(t *testing.T) {
+	t.Run("ArrayStack", func(t *testing.T) {
+		stack, err := NewArrayStackWithCapacity[int](3)
+		assertError(t, err, nil)
+		assertError(t, stack.PushAll(1, 2), nil)
+
+		clone := stack.Clone()
+		assertError(t, clone.Push(3), nil)
+		_, _ = stack.Pop()
+
+		assertSlice(t, clone.ToSlice(), []int{3, 2, 1})
+		assertSlice(t, stack.ToSlice(), []int{1})
+		assertEqual(t, clone.IsFull(), true)
+	})
+
+	t.Run("LinkedListStack", func(t *testing.T) {
+		stack, err := NewLinkedListStackWithCapacity[int](3)
+		assertError(t, err, nil)
+		assertError(t, stack.PushAll(1, 2), nil)
+
+		clone := stack.Clone()
+		assertError(t, clone.Push(3), nil)
+		_, _ = stack.Pop()
+
+		assertSlice(t, clone.ToSlice(), []int{3, 2, 1})
+		assertSlice(t, stack.ToSlice(), []int{1})
+		assertEqual(t, clone.IsFull(), true)
+	})
+
+	t.Run("Clone of empty stack", func(t *testing.T) {
+		assertLength(t, NewArrayStack[int]().Clone(), 0)
+		assertLength(t, NewLinkedListStack[int]().Clone(), 0)
+	})
+}
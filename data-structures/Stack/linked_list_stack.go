@@ -1,63 +1,195 @@
 package stack
 
-import "container/list"
+import "iter"
 
-type LinkedListStack struct {
-	container *list.List
-	length    int
-	capacity  int
+// stackNode is a unit of a linked list stack pointing to the element below.
+type stackNode[T any] struct {
+	value T
+	next  *stackNode[T]
 }
 
-func NewLinkedListStack(capacity int) *LinkedListStack {
-	return &LinkedListStack{
-		container: list.New(),
-		length:    0,
-		capacity:  capacity,
+// minFreeNodes is a number of popped nodes a stack keeps for reuse regardless of
+// its size, so short bursts of pushes and pops don't allocate.
+const minFreeNodes = 64
+
+// LinkedListStack is a stack on a singly linked chain of nodes. Popped nodes are
+// kept in a free list and reused by later pushes. The free list holds at most as
+// many nodes as a stack does, but no less than minFreeNodes, so a shrinking
+// stack gives memory back instead of keeping nodes of its peak size.
+type LinkedListStack[T any] struct {
+	top      *stackNode[T]
+	free     *stackNode[T]
+	freeSize int
+	length   int
+	capacity int
+}
+
+// NewLinkedListStack creates an unbounded stack.
+func NewLinkedListStack[T any]() *LinkedListStack[T] {
+	return &LinkedListStack[T]{
+		top:      nil,
+		free:     nil,
+		freeSize: 0,
+		length:   0,
+		capacity: 0,
+	}
+}
+
+// NewLinkedListStackWithCapacity creates a stack that holds at most capacity
+// elements. Capacity should be more than 0.
+func NewLinkedListStackWithCapacity[T any](capacity int) (*LinkedListStack[T], error) {
+	if err := checkCapacity(capacity); err != nil {
+		return nil, err
 	}
+	return &LinkedListStack[T]{
+		top:      nil,
+		free:     nil,
+		freeSize: 0,
+		length:   0,
+		capacity: capacity,
+	}, nil
 }
 
-func (s *LinkedListStack) Push(element interface{}) error {
+func (s *LinkedListStack[T]) Push(element T) error {
 	if s.IsFull() {
 		return ErrorExceededCapacity
 	}
-	s.container.PushBack(element)
+	node := s.free
+	if node != nil {
+		s.free = node.next
+		s.freeSize--
+	} else {
+		node = &stackNode[T]{}
+	}
+	node.value, node.next = element, s.top
+	s.top = node
 	s.length++
 	return nil
 }
 
-func (s *LinkedListStack) IsFull() bool {
-	return s.length >= s.capacity
+// TryPush puts an element onto a stack and reports whether there was room for it.
+func (s *LinkedListStack[T]) TryPush(element T) bool {
+	return s.Push(element) == nil
 }
 
-func (s *LinkedListStack) IsEmpty() bool {
+// IsFull reports whether a bounded stack has reached its capacity. An unbounded
+// stack is never full.
+func (s *LinkedListStack[T]) IsFull() bool {
+	return s.capacity > 0 && s.length >= s.capacity
+}
+
+func (s *LinkedListStack[T]) IsEmpty() bool {
 	return s.length == 0
 }
 
-func (s *LinkedListStack) Size() int {
+func (s *LinkedListStack[T]) Size() int {
 	return s.length
 }
 
-func (s *LinkedListStack) Pop() (interface{}, error) {
-	if lastElement, err := s.peek(); err != nil {
-		return nil, err
-	} else {
-		s.container.Remove(lastElement)
-		s.length--
-		return lastElement.Value, nil
+func (s *LinkedListStack[T]) Pop() (T, error) {
+	value, ok := s.TryPop()
+	if !ok {
+		return value, ErrorEmptyStack
 	}
+	return value, nil
 }
 
-func (s *LinkedListStack) peek() (*list.Element, error) {
-	if s.IsEmpty() {
-		return nil, ErrorEmptyStack
+// TryPop takes an element from the top of a stack and reports whether a stack
+// wasn't empty.
+func (s *LinkedListStack[T]) TryPop() (T, bool) {
+	var zero T
+	node := s.top
+	if node == nil {
+		return zero, false
 	}
-	return s.container.Back(), nil
+	value := node.value
+	s.top = node.next
+	s.length--
+	// A bound of the free list goes down by one node at most, so keeping the
+	// list within it takes dropping one node at most.
+	switch limit := max(s.length, minFreeNodes); {
+	case s.freeSize < limit:
+		// A node in the free list shouldn't keep a popped value reachable.
+		node.value, node.next = zero, s.free
+		s.free = node
+		s.freeSize++
+	case s.freeSize > limit:
+		s.free = s.free.next
+		s.freeSize--
+	}
+	return value, true
 }
 
-func (s *LinkedListStack) Peek() (interface{}, error) {
-	if element, err := s.peek(); err != nil {
-		return nil, err
-	} else {
-		return element.Value, nil
+func (s *LinkedListStack[T]) Peek() (T, error) {
+	if s.top == nil {
+		var zero T
+		return zero, ErrorEmptyStack
+	}
+	return s.top.value, nil
+}
+
+// Iterate returns an iterator over elements of a stack from the top to the
+// bottom. A stack shouldn't be changed during iteration.
+func (s *LinkedListStack[T]) Iterate() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := s.top; node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a copy of elements of a stack from the top to the bottom.
+func (s *LinkedListStack[T]) ToSlice() []T {
+	values := make([]T, 0, s.length)
+	for value := range s.Iterate() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// PushAll puts values onto a stack in the given order, so the last one ends up
+// on the top. If all values don't fit into a bounded stack returns an error and
+// pushes nothing.
+func (s *LinkedListStack[T]) PushAll(values ...T) error {
+	if s.capacity > 0 && s.length+len(values) > s.capacity {
+		return ErrorExceededCapacity
+	}
+	for _, value := range values {
+		_ = s.Push(value)
+	}
+	return nil
+}
+
+// PopN takes n elements from the top of a stack and returns them from the top
+// down. If a stack has less than n elements returns an error and pops nothing.
+func (s *LinkedListStack[T]) PopN(n int) ([]T, error) {
+	if n < 0 || n > s.length {
+		return nil, ErrorWrongCount
+	}
+	values := make([]T, n)
+	for index := range values {
+		values[index], _ = s.TryPop()
+	}
+	return values, nil
+}
+
+// Clear removes all elements from a stack and releases its nodes including
+// the ones kept for reuse.
+func (s *LinkedListStack[T]) Clear() {
+	s.top, s.free, s.freeSize, s.length = nil, nil, 0, 0
+}
+
+// Clone returns an independent copy of a stack with the same capacity.
+func (s *LinkedListStack[T]) Clone() *LinkedListStack[T] {
+	clone := &LinkedListStack[T]{
+		length:   s.length,
+		capacity: s.capacity,
+	}
+	for node, last := s.top, &clone.top; node != nil; node = node.next {
+		*last = &stackNode[T]{value: node.value}
+		last = &(*last).next
 	}
+	return clone
 }
@@ -0,0 +1,99 @@
+package stack
+
+import (
+	"container/list"
+	"testing"
+)
+
+func TestLinkedListStack_ReusesNodes(t *testing.T) {
+	stack := NewLinkedListStack[int]()
+	for value := 0; value < 10; value++ {
+		assertError(t, stack.Push(value), nil)
+	}
+	for !stack.IsEmpty() {
+		_, _ = stack.Pop()
+	}
+
+	allocations := testing.AllocsPerRun(100, func() {
+		for value := 0; value < 10; value++ {
+			_ = stack.Push(value)
+		}
+		for value := 9; value >= 0; value-- {
+			popped, _ := stack.Pop()
+			assertEqual(t, popped, value)
+		}
+	})
+
+	assertEqual(t, allocations, float64(0))
+}
+
+func TestLinkedListStack_BoundsFreeList(t *testing.T) {
+	stack := NewLinkedListStack[int]()
+	for value := 0; value < 1000; value++ {
+		assertError(t, stack.Push(value), nil)
+	}
+
+	_, err := stack.PopN(500)
+	assertError(t, err, nil)
+	assertEqual(t, stack.freeSize, 500)
+
+	_, err = stack.PopN(400)
+	assertError(t, err, nil)
+	assertEqual(t, stack.freeSize, 100)
+
+	_, err = stack.PopN(100)
+	assertError(t, err, nil)
+	assertEqual(t, stack.freeSize, minFreeNodes)
+}
+
+// containerListStack is the former LinkedListStack built on container/list,
+// which is kept as a baseline for benchmarks.
+type containerListStack[T any] struct {
+	container *list.List
+	length    int
+}
+
+func (s *containerListStack[T]) Push(element T) error {
+	s.container.PushBack(element)
+	s.length++
+	return nil
+}
+
+func (s *containerListStack[T]) TryPop() (T, bool) {
+	if s.length == 0 {
+		var zero T
+		return zero, false
+	}
+	s.length--
+	return s.container.Remove(s.container.Back()).(T), true
+}
+
+// benchmarkBurst pushes and then pops a burst of elements, which is a typical
+// usage of a stack, e.g. in a depth-first traversal.
+func benchmarkBurst(b *testing.B, stack interface {
+	Push(element int) error
+	TryPop() (int, bool)
+}) {
+	const burst = 64
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for value := 0; value < burst; value++ {
+			_ = stack.Push(value)
+		}
+		for value := 0; value < burst; value++ {
+			_, _ = stack.TryPop()
+		}
+	}
+}
+
+func BenchmarkLinkedListStack_Burst(b *testing.B) {
+	benchmarkBurst(b, NewLinkedListStack[int]())
+}
+
+func BenchmarkContainerListStack_Burst(b *testing.B) {
+	benchmarkBurst(b, &containerListStack[int]{container: list.New()})
+}
+
+func BenchmarkArrayStack_Burst(b *testing.B) {
+	benchmarkBurst(b, NewArrayStack[int]())
+}
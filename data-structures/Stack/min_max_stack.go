@@ -0,0 +1,88 @@
+package stack
+
+import "cmp"
+
+var _ Stack[int] = (*MinMaxStack[int])(nil)
+
+type extremes[T cmp.Ordered] struct {
+	value T
+	min   T
+	max   T
+}
+
+// MinMaxStack is a stack that answers its minimum and maximum elements in
+// constant time. Every entry remembers extremes of itself and all entries below.
+type MinMaxStack[T cmp.Ordered] struct {
+	container Stack[extremes[T]]
+}
+
+// NewMinMaxStack creates an unbounded min/max stack.
+func NewMinMaxStack[T cmp.Ordered]() *MinMaxStack[T] {
+	return &MinMaxStack[T]{
+		container: NewArrayStack[extremes[T]](),
+	}
+}
+
+// NewMinMaxStackWithCapacity creates a min/max stack that holds at most capacity
+// elements. Capacity should be more than 0.
+func NewMinMaxStackWithCapacity[T cmp.Ordered](capacity int) (*MinMaxStack[T], error) {
+	container, err := NewArrayStackWithCapacity[extremes[T]](capacity)
+	if err != nil {
+		return nil, err
+	}
+	return &MinMaxStack[T]{container: container}, nil
+}
+
+func (s *MinMaxStack[T]) Push(element T) error {
+	entry := extremes[T]{value: element, min: element, max: element}
+	if top, err := s.container.Peek(); err == nil {
+		entry.min, entry.max = min(top.min, element), max(top.max, element)
+	}
+	return s.container.Push(entry)
+}
+
+// TryPush puts an element onto a stack and reports whether there was room for it.
+func (s *MinMaxStack[T]) TryPush(element T) bool {
+	return s.Push(element) == nil
+}
+
+func (s *MinMaxStack[T]) Peek() (T, error) {
+	top, err := s.container.Peek()
+	return top.value, err
+}
+
+func (s *MinMaxStack[T]) Pop() (T, error) {
+	top, err := s.container.Pop()
+	return top.value, err
+}
+
+// TryPop takes an element from the top of a stack and reports whether a stack
+// wasn't empty.
+func (s *MinMaxStack[T]) TryPop() (T, bool) {
+	top, ok := s.container.TryPop()
+	return top.value, ok
+}
+
+// Min returns the least element of a stack. If a stack is empty returns an error.
+func (s *MinMaxStack[T]) Min() (T, error) {
+	top, err := s.container.Peek()
+	return top.min, err
+}
+
+// Max returns the greatest element of a stack. If a stack is empty returns an error.
+func (s *MinMaxStack[T]) Max() (T, error) {
+	top, err := s.container.Peek()
+	return top.max, err
+}
+
+func (s *MinMaxStack[T]) IsFull() bool {
+	return s.container.IsFull()
+}
+
+func (s *MinMaxStack[T]) IsEmpty() bool {
+	return s.container.IsEmpty()
+}
+
+func (s *MinMaxStack[T]) Size() int {
+	return s.container.Size()
+}
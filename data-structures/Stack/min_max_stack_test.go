@@ -0,0 +1,63 @@
+package stack
+
+import "testing"
+
+func TestMinMaxStack(t *testing.T) {
+	t.Run("Min and max of empty stack", func(t *testing.T) {
+		stack := NewMinMaxStack[int]()
+
+		_, err := stack.Min()
+		assertError(t, err, ErrorEmptyStack)
+
+		_, err = stack.Max()
+		assertError(t, err, ErrorEmptyStack)
+	})
+
+	t.Run("Min and max follow pushes and pops", func(t *testing.T) {
+		stack := NewMinMaxStack[int]()
+		pushes := []struct {
+			value, min, max int
+		}{
+			{5, 5, 5},
+			{3, 3, 5},
+			{7, 3, 7},
+			{3, 3, 7},
+			{1, 1, 7},
+			{9, 1, 9},
+		}
+
+		for _, push := range pushes {
+			assertError(t, stack.Push(push.value), nil)
+			assertExtremes(t, stack, push.min, push.max)
+		}
+		for index := len(pushes) - 1; index > 0; index-- {
+			value, err := stack.Pop()
+			assertError(t, err, nil)
+			assertEqual(t, value, pushes[index].value)
+			assertExtremes(t, stack, pushes[index-1].min, pushes[index-1].max)
+		}
+	})
+
+	t.Run("Works with strings", func(t *testing.T) {
+		stack := NewMinMaxStack[string]()
+		for _, value := range []string{"pear", "apple", "plum"} {
+			assertError(t, stack.Push(value), nil)
+		}
+
+		minimum, _ := stack.Min()
+		maximum, _ := stack.Max()
+
+		assertEqual(t, minimum, "apple")
+		assertEqual(t, maximum, "plum")
+	})
+}
+
+func assertExtremes(t *testing.T, stack *MinMaxStack[int], expectedMin, expectedMax int) {
+	t.Helper()
+	actualMin, err := stack.Min()
+	assertError(t, err, nil)
+	assertEqual(t, actualMin, expectedMin)
+	actualMax, err := stack.Max()
+	assertError(t, err, nil)
+	assertEqual(t, actualMax, expectedMax)
+}
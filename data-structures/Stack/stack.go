@@ -2,19 +2,48 @@ package stack
 
 import (
 	"errors"
+	"iter"
 )
 
 var (
 	ErrorExceededCapacity = errors.New("capacity is exceeded")
+	ErrorWrongCapacity    = errors.New("capacity should be > 0")
 	ErrorEmptyStack       = errors.New("can't perform pop, peek on empty stack")
+	ErrorEmptyQueue       = errors.New("can't perform dequeue, peek on empty queue")
+	ErrorWrongCount       = errors.New("count should be >= 0 and <= size of a stack")
 )
 
 // Stack is an ADT.
-type Stack interface {
-	Push(element interface{}) error
-	Peek() (interface{}, error)
-	Pop() (interface{}, error)
+type Stack[T any] interface {
+	Push(element T) error
+	TryPush(element T) bool
+	Peek() (T, error)
+	Pop() (T, error)
+	TryPop() (T, bool)
 	IsFull() bool
 	IsEmpty() bool
 	Size() int
 }
+
+// IterableStack is a stack whose contents can be inspected without popping and
+// changed in bulk.
+type IterableStack[T any] interface {
+	Stack[T]
+	Iterate() iter.Seq[T]
+	ToSlice() []T
+	PushAll(values ...T) error
+	PopN(n int) ([]T, error)
+	Clear()
+}
+
+var (
+	_ IterableStack[int] = (*ArrayStack[int])(nil)
+	_ IterableStack[int] = (*LinkedListStack[int])(nil)
+)
+
+func checkCapacity(capacity int) error {
+	if capacity <= 0 {
+		return ErrorWrongCapacity
+	}
+	return nil
+}
@@ -2,7 +2,7 @@ package stack
 
 import "testing"
 
-func assertLength(t *testing.T, stack Stack, expected int) {
+func assertLength(t *testing.T, stack Stack[int], expected int) {
 	t.Helper()
 	actual := stack.Size()
 	if actual != expected {
@@ -24,14 +24,67 @@ func assertEqual(t *testing.T, actual, expected interface{}) {
 	}
 }
 
-func initStacks(t *testing.T, size int) []Stack {
+func initStacks(t *testing.T, size int) []Stack[int] {
 	t.Helper()
-	return []Stack{
-		NewLinkedListStack(size),
-		NewArrayStack(size),
+	linkedListStack, err := NewLinkedListStackWithCapacity[int](size)
+	assertError(t, err, nil)
+	arrayStack, err := NewArrayStackWithCapacity[int](size)
+	assertError(t, err, nil)
+	minMaxStack, err := NewMinMaxStackWithCapacity[int](size)
+	assertError(t, err, nil)
+	aggregateStack, err := NewAggregateStackWithCapacity(size, sum)
+	assertError(t, err, nil)
+	concurrentStack, err := NewConcurrentStackWithCapacity[int](size)
+	assertError(t, err, nil)
+	return []Stack[int]{
+		linkedListStack,
+		arrayStack,
+		minMaxStack,
+		aggregateStack,
+		concurrentStack,
 	}
 }
 
+func initUnboundedStacks(t *testing.T) []Stack[int] {
+	t.Helper()
+	return []Stack[int]{
+		NewLinkedListStack[int](),
+		NewArrayStack[int](),
+		NewMinMaxStack[int](),
+		NewAggregateStack(sum),
+		NewConcurrentStack[int](),
+	}
+}
+
+func TestNewStack(t *testing.T) {
+	t.Run("Init stack with incorrect capacity", func(t *testing.T) {
+		for _, capacity := range []int{-1, 0} {
+			_, err := NewArrayStackWithCapacity[int](capacity)
+			assertError(t, err, ErrorWrongCapacity)
+
+			_, err = NewLinkedListStackWithCapacity[int](capacity)
+			assertError(t, err, ErrorWrongCapacity)
+
+			_, err = NewMinMaxStackWithCapacity[int](capacity)
+			assertError(t, err, ErrorWrongCapacity)
+
+			_, err = NewAggregateStackWithCapacity(capacity, sum)
+			assertError(t, err, ErrorWrongCapacity)
+
+			_, err = NewConcurrentStackWithCapacity[int](capacity)
+			assertError(t, err, ErrorWrongCapacity)
+		}
+	})
+
+	t.Run("Init unbounded stack", func(t *testing.T) {
+		for _, stack := range initUnboundedStacks(t) {
+			assertLength(t, stack, 0)
+			assertEqual(t, stack.IsEmpty(), true)
+			assertEqual(t, stack.IsFull(), false)
+		}
+	})
+}
+
 func TestPush(t *testing.T) {
 	t.Run("Push should put an element onto a stack", func(t *testing.T) {
 		for _, stack := range initStacks(t, 1) {
@@ -108,3 +161,48 @@ func TestPop(t *testing.T) {
 		}
 	})
 }
+
+func TestUnboundedStack(t *testing.T) {
+	t.Run("Unbounded stack grows as needed", func(t *testing.T) {
+		for _, stack := range initUnboundedStacks(t) {
+			for i := 0; i < 1000; i++ {
+				assertError(t, stack.Push(i), nil)
+			}
+
+			assertLength(t, stack, 1000)
+			assertEqual(t, stack.IsFull(), false)
+
+			for i := 999; i >= 0; i-- {
+				actual, err := stack.Pop()
+
+				assertError(t, err, nil)
+				assertEqual(t, actual, i)
+			}
+			assertEqual(t, stack.IsEmpty(), true)
+		}
+	})
+}
+
+func TestTryPushTryPop(t *testing.T) {
+	t.Run("TryPush reports whether there was room", func(t *testing.T) {
+		for _, stack := range initStacks(t, 1) {
+			assertEqual(t, stack.TryPush(1), true)
+			assertEqual(t, stack.TryPush(2), false)
+			assertLength(t, stack, 1)
+		}
+	})
+
+	t.Run("TryPop reports whether a stack wasn't empty", func(t *testing.T) {
+		for _, stack := range initUnboundedStacks(t) {
+			_ = stack.Push(1)
+
+			actual, ok := stack.TryPop()
+			assertEqual(t, actual, 1)
+			assertEqual(t, ok, true)
+
+			actual, ok = stack.TryPop()
+			assertEqual(t, actual, 0)
+			assertEqual(t, ok, false)
+		}
+	})
+}
@@ -0,0 +1,3 @@
+module github.com/0eu/data-structures-and-algorithms
+
+go 1.23